@@ -0,0 +1,184 @@
+package property
+
+import "sync"
+
+// Overflow tells AsyncBroadcast what to do when queue of a receiver is full.
+type Overflow int
+
+const (
+	// OverflowBlock blocks Change until the receiver catches up.
+	OverflowBlock Overflow = iota
+	// OverflowDropOldest discards the oldest pending value of the receiver.
+	OverflowDropOldest
+	// OverflowDropNewest discards the value being broadcast for the receiver.
+	OverflowDropNewest
+)
+
+// AsyncBroadcast returns implementation of Property[T any] interface.
+// it is the asynchronous variant of Broadcast, receivers run on a pool of
+// workers so a slow receiver does not block the writer.
+//
+// Every receiver has its own queue of size pending values and gets values
+// in change order, overflow decides what happens when its queue is full.
+// At most workers receivers run at the same time, and a receiver never runs
+// in parallel with itself. Close stops accepting changes, waits until every
+// queue is drained and stops the workers.
+//
+// panic when:
+//   - workers or size is less than 1.
+//   - receivers is empty.
+//   - property is nil.
+//   - recivers has nil function (default panic message).
+func AsyncBroadcast[T any](workers, size int, overflow Overflow, receivers []func(T), property Property[T]) *asyncBroadcast[T] {
+	if workers < 1 || size < 1 {
+		panic("property.AsyncBroadcast: cannot be created with less than one worker or queue size")
+	}
+	if len(receivers) == 0 {
+		panic("property.AsyncBroadcast: cannot be created with zero receivers")
+	}
+	if property == nil {
+		panic("property.AsyncBroadcast: cannot be created from nil property")
+	}
+	b := &asyncBroadcast[T]{
+		size:     size,
+		overflow: overflow,
+		ready:    make(chan *receiver[T], len(receivers)),
+		property: property,
+	}
+	b.drained = sync.NewCond(&b.mu)
+	for _, fx := range receivers {
+		b.receivers = append(b.receivers, &receiver[T]{fx: fx})
+	}
+	b.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go b.worker()
+	}
+	return b
+}
+
+type asyncBroadcast[T any] struct {
+	// changing serializes Change so values are queued in change order
+	changing sync.Mutex
+	// mu guards receivers state and closed
+	mu sync.Mutex
+	// drained is signalled whenever a receiver makes progress
+	drained   *sync.Cond
+	size      int
+	overflow  Overflow
+	receivers []*receiver[T]
+	// ready holds receivers which have pending values, each at most once
+	ready    chan *receiver[T]
+	closed   bool
+	wg       sync.WaitGroup
+	property Property[T]
+}
+
+type receiver[T any] struct {
+	fx      func(T)
+	pending []T
+	// scheduled is true while receiver is in ready or running
+	scheduled bool
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, the value is queued for every receiver.
+//
+// ErrClosed or error of underlying property is returned.
+func (b *asyncBroadcast[T]) Change(value T) error {
+	b.changing.Lock()
+	defer b.changing.Unlock()
+	b.mu.Lock()
+	closed := b.closed
+	b.mu.Unlock()
+	if closed {
+		return ErrClosed
+	}
+	if err := b.property.Change(value); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, r := range b.receivers {
+		b.enqueue(r, value)
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (b *asyncBroadcast[T]) Value() (T, error) {
+	return b.property.Value()
+}
+
+// Close message stops accepting changes and returns once every receiver
+// got its pending values. Close is idempotent and always returns nil.
+func (b *asyncBroadcast[T]) Close() error {
+	b.changing.Lock()
+	defer b.changing.Unlock()
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		b.wg.Wait()
+		return nil
+	}
+	b.closed = true
+	for !b.idle() {
+		b.drained.Wait()
+	}
+	b.mu.Unlock()
+	close(b.ready)
+	b.wg.Wait()
+	return nil
+}
+
+// enqueue must be called while holding mu.
+func (b *asyncBroadcast[T]) enqueue(r *receiver[T], value T) {
+	for len(r.pending) >= b.size {
+		switch b.overflow {
+		case OverflowDropOldest:
+			r.pending = r.pending[1:]
+		case OverflowDropNewest:
+			return
+		default:
+			b.drained.Wait()
+		}
+	}
+	r.pending = append(r.pending, value)
+	if !r.scheduled {
+		r.scheduled = true
+		b.ready <- r
+	}
+}
+
+// idle must be called while holding mu.
+func (b *asyncBroadcast[T]) idle() bool {
+	for _, r := range b.receivers {
+		if r.scheduled {
+			return false
+		}
+	}
+	return true
+}
+
+// worker delivers one pending value of a ready receiver at a time,
+// the receiver is put back to ready while it has pending values.
+func (b *asyncBroadcast[T]) worker() {
+	defer b.wg.Done()
+	for r := range b.ready {
+		b.mu.Lock()
+		value := r.pending[0]
+		r.pending = r.pending[1:]
+		b.drained.Broadcast()
+		b.mu.Unlock()
+		r.fx(value)
+		b.mu.Lock()
+		if len(r.pending) > 0 {
+			b.ready <- r
+		} else {
+			r.scheduled = false
+			b.drained.Broadcast()
+		}
+		b.mu.Unlock()
+	}
+}
@@ -0,0 +1,183 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// AuditRecord describes a single Change received by Audited.
+type AuditRecord struct {
+	// Property is the name given to Audited.
+	Property string    `json:"property"`
+	Actor    string    `json:"actor"`
+	Time     time.Time `json:"time"`
+	// Old is the value before the change, nil when it could not be read.
+	Old     any  `json:"old"`
+	New     any  `json:"new"`
+	Success bool `json:"success"`
+	// Error of the change, including constraint violations, empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink stores audit records.
+type AuditSink interface {
+	Write(AuditRecord) error
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx which carries actor,
+// it is read by ContextAudited.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns actor carried by ctx, or empty string.
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Audited implements Property[T any] interface which writes an AuditRecord
+// to sink for every change, whether it succeeds or not. To record
+// constraint violations, Audited must be placed above Guard:
+//
+//	property.Audited[T]("email", clock, sink, property.Guard[T](cons, datasource))
+//
+// messages:
+//   - Change   records the change without actor.
+//   - ChangeAs records the change made by actor.
+//   - Value    delegates to underlying property, it is not recorded.
+//
+// Old value is read through underlying Value before the change. When sink
+// fails, its error is returned joined with error of the change, so the
+// change may have been applied even if an error is returned.
+//
+// panic when:
+//   - clock is nil.
+//   - sink is nil.
+//   - property is nil.
+func Audited[T any](name string, clock Clock, sink AuditSink, property Property[T]) audited[T] {
+	if clock == nil {
+		panic("property.Audited: cannot be created from nil clock")
+	}
+	if sink == nil {
+		panic("property.Audited: cannot be created from nil sink")
+	}
+	if property == nil {
+		panic("property.Audited: cannot be created from nil property")
+	}
+	return audited[T]{
+		name:     name,
+		clock:    clock,
+		sink:     sink,
+		property: property,
+	}
+}
+
+// audited implements Property[T any] interface
+type audited[T any] struct {
+	name     string
+	clock    Clock
+	sink     AuditSink
+	property Property[T]
+}
+
+// Change message delegates to underlying property and records
+// the change without actor.
+func (a audited[T]) Change(value T) error {
+	return a.ChangeAs("", value)
+}
+
+// ChangeAs message delegates to underlying property and records
+// the change made by actor.
+//
+// Errors of underlying property and sink are returned.
+func (a audited[T]) ChangeAs(actor string, value T) error {
+	var old any
+	if v, err := a.property.Value(); err == nil {
+		old = v
+	}
+	err := a.property.Change(value)
+	return audit(a.sink, a.name, actor, a.clock.Now(), old, value, err)
+}
+
+// Value message returns actual value by delegation to underlying property.
+func (a audited[T]) Value() (T, error) {
+	return a.property.Value()
+}
+
+// ContextAudited is the context-aware variant of Audited,
+// actor is read from context by ActorFrom.
+//
+// panic when:
+//   - clock is nil.
+//   - sink is nil.
+//   - property is nil.
+func ContextAudited[T any](name string, clock Clock, sink AuditSink, property ContextProperty[T]) contextAudited[T] {
+	if clock == nil {
+		panic("property.ContextAudited: cannot be created from nil clock")
+	}
+	if sink == nil {
+		panic("property.ContextAudited: cannot be created from nil sink")
+	}
+	if property == nil {
+		panic("property.ContextAudited: cannot be created from nil property")
+	}
+	return contextAudited[T]{
+		name:     name,
+		clock:    clock,
+		sink:     sink,
+		property: property,
+	}
+}
+
+// contextAudited implements ContextProperty[T any] interface
+type contextAudited[T any] struct {
+	name     string
+	clock    Clock
+	sink     AuditSink
+	property ContextProperty[T]
+}
+
+// Change message delegates to underlying property and records
+// the change made by actor of ctx.
+//
+// Errors of underlying property and sink are returned.
+func (a contextAudited[T]) Change(ctx context.Context, value T) error {
+	var old any
+	if v, err := a.property.Value(ctx); err == nil {
+		old = v
+	}
+	err := a.property.Change(ctx, value)
+	return audit(a.sink, a.name, ActorFrom(ctx), a.clock.Now(), old, value, err)
+}
+
+// Value message returns actual value by delegation to underlying property.
+func (a contextAudited[T]) Value(ctx context.Context) (T, error) {
+	return a.property.Value(ctx)
+}
+
+// audit writes the record of a change to sink, it returns err
+// joined with error of sink.
+func audit(sink AuditSink, name, actor string, now time.Time, old, value any, err error) error {
+	record := AuditRecord{
+		Property: name,
+		Actor:    actor,
+		Time:     now,
+		Old:      old,
+		New:      value,
+		Success:  err == nil,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if serr := sink.Write(record); serr != nil {
+		if err == nil {
+			return serr
+		}
+		return errors.Join(err, serr)
+	}
+	return err
+}
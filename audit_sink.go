@@ -0,0 +1,79 @@
+package property
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+// MemorySink returns implementation of AuditSink interface which keeps
+// records in memory, it is safe for concurrent use.
+func MemorySink() *memorySink {
+	return &memorySink{}
+}
+
+type memorySink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (m *memorySink) Write(record AuditRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records = append(m.records, record)
+	return nil
+}
+
+// Records message returns a copy of written records, oldest first.
+func (m *memorySink) Records() []AuditRecord {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]AuditRecord(nil), m.records...)
+}
+
+// JSONLinesSink returns implementation of AuditSink interface which encodes
+// every record as a single JSON line to w, it is safe for concurrent use.
+//
+// # Panic when w is nil
+func JSONLinesSink(w io.Writer) *jsonLinesSink {
+	if w == nil {
+		panic("property.JSONLinesSink: cannot be created from nil writer")
+	}
+	return &jsonLinesSink{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// OpenJSONLinesSink opens (or creates) file at path for appending and
+// returns JSONLinesSink writing to it, Close closes the file.
+func OpenJSONLinesSink(path string) (*jsonLinesSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	sink := JSONLinesSink(file)
+	sink.closer = file
+	return sink, nil
+}
+
+type jsonLinesSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+func (j *jsonLinesSink) Write(record AuditRecord) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.encoder.Encode(record)
+}
+
+// Close message closes the file opened by OpenJSONLinesSink,
+// it does nothing for a sink created by JSONLinesSink.
+func (j *jsonLinesSink) Close() error {
+	if j.closer == nil {
+		return nil
+	}
+	return j.closer.Close()
+}
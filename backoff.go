@@ -0,0 +1,58 @@
+package property
+
+import "time"
+
+// Backoff returns how long to wait before the given retry,
+// first retry is 1.
+type Backoff func(retry int) time.Duration
+
+// ConstantBackoff returns Backoff which waits d before every retry.
+//
+// # Panic when d is negative
+func ConstantBackoff(d time.Duration) Backoff {
+	if d < 0 {
+		panic("property.ConstantBackoff: cannot be created with negative duration")
+	}
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff returns Backoff which waits initial before first retry
+// and doubles the wait on every following retry up to max.
+//
+// # Panic unless 0 < initial <= max
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	if initial <= 0 || max < initial {
+		panic("property.ExponentialBackoff: cannot be created unless 0 < initial <= max")
+	}
+	return func(retry int) time.Duration {
+		d := initial
+		for i := 1; i < retry && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// JitteredBackoff returns Backoff which waits a random part of what backoff
+// returns (full jitter), random must return a number in [0, 1) such as
+// rand.Float64.
+//
+// panic when:
+//   - backoff is nil.
+//   - random is nil.
+func JitteredBackoff(backoff Backoff, random func() float64) Backoff {
+	if backoff == nil {
+		panic("property.JitteredBackoff: cannot be created from nil backoff")
+	}
+	if random == nil {
+		panic("property.JitteredBackoff: cannot be created from nil random")
+	}
+	return func(retry int) time.Duration {
+		return time.Duration(float64(backoff(retry)) * random())
+	}
+}
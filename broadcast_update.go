@@ -0,0 +1,60 @@
+package property
+
+import "errors"
+
+// BroadcastUpdate returns implementation of Property[T any] interface.
+// it is the variant of Broadcast whose receivers get both old and new value,
+// which is what a cascading natural primary key update needs to find rows
+// that depend upon the old key.
+//
+// Old value is read through underlying property before the change, when it
+// cannot be read the change is not applied. Receivers get the zero value of
+// T as old value when underlying property reports ErrNotFound.
+//
+// panic when:
+//   - receivers is empty.
+//   - property is nil.
+//   - receivers has nil function (default panic message).
+func BroadcastUpdate[T any](receivers []func(old, new T), property Property[T]) *broadcastUpdate[T] {
+	if len(receivers) == 0 {
+		panic("property.BroadcastUpdate: cannot be created with zero receivers")
+	}
+	if property == nil {
+		panic("property.BroadcastUpdate: cannot be created from nil property")
+	}
+	return &broadcastUpdate[T]{
+		receivers: receivers,
+		property:  property,
+	}
+}
+
+type broadcastUpdate[T any] struct {
+	receivers []func(old, new T)
+	property  Property[T]
+}
+
+// Change message reads old value and delegates to underlying property to
+// update itself, when no error occur receivers get notified with old and
+// new value.
+//
+// Error of underlying property is returned.
+func (b broadcastUpdate[T]) Change(value T) error {
+	old, err := b.property.Value()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := b.property.Change(value); err != nil {
+		return err
+	}
+	for _, fx := range b.receivers {
+		fx(old, value)
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (b broadcastUpdate[T]) Value() (T, error) {
+	return b.property.Value()
+}
@@ -8,10 +8,12 @@ package property
 // messages:
 //   - Change updates underlying property and in memory value if no error occur.
 //   - Value  returns in-memory cached value and nil.
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh re-reads underlying property.
 //
 // # Panic when property argument is nil
 //
-// See Cacheable if lazy loading is needed.
+// See LazyCache if lazy loading is needed.
 func Cache[T any](value T, property Property[T]) *cache[T] {
 	if property == nil {
 		panic("property.Cache: cannot be created from nil property")
@@ -25,6 +27,7 @@ func Cache[T any](value T, property Property[T]) *cache[T] {
 // Cache implements Property[T any] interface
 type cache[T any] struct {
 	value    T
+	stale    bool
 	property Property[T]
 }
 
@@ -36,12 +39,38 @@ func (c *cache[T]) Change(value T) error {
 	err := c.property.Change(value)
 	if err == nil {
 		c.value = value
+		c.stale = false
 	}
 	return err
 }
 
 // Value message returns in-memory cached value and nil.
-// No delegation occurs to underlying property.
+// No delegation occurs to underlying property unless cache is invalidated.
 func (c *cache[T]) Value() (T, error) {
+	if c.stale {
+		if err := c.Refresh(); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
 	return c.value, nil
 }
+
+// Invalidate message empties the cache, next Value delegates
+// to underlying property.
+func (c *cache[T]) Invalidate() {
+	c.stale = true
+}
+
+// Refresh message delegates to underlying property, when no error occur
+// in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *cache[T]) Refresh() error {
+	value, err := c.property.Value()
+	if err == nil {
+		c.value = value
+		c.stale = false
+	}
+	return err
+}
@@ -0,0 +1,274 @@
+package property
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by CircuitBreaker without delegation to
+// underlying property while the circuit is open.
+var ErrCircuitOpen = errors.New("property: circuit is open")
+
+// errPanicked is counted as the outcome of a message whose underlying
+// property panicked, the panic itself is not recovered.
+var errPanicked = errors.New("property: underlying property panicked")
+
+// CircuitState is the state of CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every message through and counts failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every message fast with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial message through, its outcome
+	// closes or opens the circuit again.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Counts holds outcomes of messages received while the circuit is closed.
+type Counts struct {
+	Requests            int
+	Failures            int
+	ConsecutiveFailures int
+}
+
+// ConsecutiveFailures returns trip function which opens the circuit
+// after n failures in a row.
+//
+// # Panic when n is less than 1
+func ConsecutiveFailures(n int) func(Counts) bool {
+	if n < 1 {
+		panic("property.ConsecutiveFailures: cannot be created with n less than 1")
+	}
+	return func(c Counts) bool {
+		return c.ConsecutiveFailures >= n
+	}
+}
+
+// FailureRatio returns trip function which opens the circuit once at least
+// min requests have been counted and the ratio of failures reaches ratio.
+//
+// # Panic unless 0 < ratio <= 1 and min >= 1
+func FailureRatio(ratio float64, min int) func(Counts) bool {
+	if ratio <= 0 || ratio > 1 || min < 1 {
+		panic("property.FailureRatio: cannot be created unless 0 < ratio <= 1 and min >= 1")
+	}
+	return func(c Counts) bool {
+		return c.Requests >= min && float64(c.Failures)/float64(c.Requests) >= ratio
+	}
+}
+
+// CircuitBreaker implements Property[T any] interface which stops delegating
+// to a failing underlying property, it is safe for concurrent use.
+//
+// While closed every message is delegated and its outcome is counted, counts
+// start again every interval (zero interval never restarts them). Once trip
+// returns true the circuit opens and every message fails with ErrCircuitOpen.
+// After cooldown the circuit becomes half-open and a single trial message is
+// delegated, success closes the circuit and failure opens it again. Every
+// non-nil error of underlying property counts as a failure.
+//
+// Outcome of a message which started before the latest state change, such as
+// a slow call made while closed that finishes during the half-open trial, is
+// ignored. A panic of underlying property counts as a failure.
+//
+// onChange is called on every state change, after the internal lock is
+// released, so it may send State to the breaker.
+//
+// panic when:
+//   - trip is nil.
+//   - interval is negative or cooldown is not positive.
+//   - clock is nil.
+//   - onChange is nil.
+//   - property is nil.
+func CircuitBreaker[T any](trip func(Counts) bool, interval, cooldown time.Duration, clock Clock, onChange func(from, to CircuitState), property Property[T]) *circuitBreaker[T] {
+	if trip == nil {
+		panic("property.CircuitBreaker: cannot be created from nil trip")
+	}
+	if interval < 0 || cooldown <= 0 {
+		panic("property.CircuitBreaker: cannot be created with negative interval or non-positive cooldown")
+	}
+	if clock == nil {
+		panic("property.CircuitBreaker: cannot be created from nil clock")
+	}
+	if onChange == nil {
+		panic("property.CircuitBreaker: cannot be created from nil onChange")
+	}
+	if property == nil {
+		panic("property.CircuitBreaker: cannot be created from nil property")
+	}
+	return &circuitBreaker[T]{
+		trip:     trip,
+		interval: interval,
+		cooldown: cooldown,
+		clock:    clock,
+		onChange: onChange,
+		since:    clock.Now(),
+		property: property,
+	}
+}
+
+// circuitBreaker implements Property[T any] interface
+type circuitBreaker[T any] struct {
+	mu       sync.Mutex
+	trip     func(Counts) bool
+	interval time.Duration
+	cooldown time.Duration
+	clock    Clock
+	onChange func(from, to CircuitState)
+	state    CircuitState
+	counts   Counts
+	// since is when current state, or counting interval, started
+	since time.Time
+	// generation is incremented on every state change, messages are tagged
+	// with it so outcomes of an earlier state are ignored
+	generation uint64
+	// probing is true while the half-open trial message is in flight
+	probing  bool
+	property Property[T]
+}
+
+// transition records a state change of circuitBreaker.
+type transition struct {
+	from, to CircuitState
+}
+
+// Change message delegates to underlying property unless the circuit is open.
+//
+// ErrCircuitOpen or error of underlying property is returned.
+func (c *circuitBreaker[T]) Change(value T) error {
+	return c.call(func() error {
+		return c.property.Change(value)
+	})
+}
+
+// Value message delegates to underlying property unless the circuit is open.
+//
+// ErrCircuitOpen or error of underlying property is returned.
+func (c *circuitBreaker[T]) Value() (T, error) {
+	var value T
+	err := c.call(func() error {
+		var err error
+		value, err = c.property.Value()
+		return err
+	})
+	return value, err
+}
+
+// State message returns current state of the circuit.
+func (c *circuitBreaker[T]) State() CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == CircuitOpen && !c.clock.Now().Before(c.since.Add(c.cooldown)) {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// call sends fx through the circuit, its outcome is counted even
+// when it panics.
+func (c *circuitBreaker[T]) call(fx func() error) error {
+	generation, err := c.before()
+	if err != nil {
+		return err
+	}
+	completed := false
+	defer func() {
+		if !completed {
+			c.after(generation, errPanicked)
+		}
+	}()
+	err = fx()
+	completed = true
+	c.after(generation, err)
+	return err
+}
+
+// before admits a message and returns the generation it belongs to.
+func (c *circuitBreaker[T]) before() (uint64, error) {
+	var changes []transition
+	defer c.notify(&changes)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	switch c.state {
+	case CircuitClosed:
+		if c.interval > 0 && !now.Before(c.since.Add(c.interval)) {
+			c.counts = Counts{}
+			c.since = now
+		}
+		return c.generation, nil
+	case CircuitOpen:
+		if now.Before(c.since.Add(c.cooldown)) {
+			return 0, ErrCircuitOpen
+		}
+		changes = append(changes, c.transit(CircuitHalfOpen, now))
+	}
+	if c.probing {
+		return 0, ErrCircuitOpen
+	}
+	c.probing = true
+	return c.generation, nil
+}
+
+// after counts the outcome of a message admitted in generation,
+// it is ignored when the state has changed since.
+func (c *circuitBreaker[T]) after(generation uint64, err error) {
+	var changes []transition
+	defer c.notify(&changes)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	now := c.clock.Now()
+	switch c.state {
+	case CircuitClosed:
+		c.counts.Requests++
+		if err == nil {
+			c.counts.ConsecutiveFailures = 0
+			return
+		}
+		c.counts.Failures++
+		c.counts.ConsecutiveFailures++
+		if c.trip(c.counts) {
+			changes = append(changes, c.transit(CircuitOpen, now))
+		}
+	case CircuitHalfOpen:
+		if err == nil {
+			changes = append(changes, c.transit(CircuitClosed, now))
+		} else {
+			changes = append(changes, c.transit(CircuitOpen, now))
+		}
+	}
+}
+
+func (c *circuitBreaker[T]) transit(to CircuitState, now time.Time) transition {
+	from := c.state
+	c.state = to
+	c.since = now
+	c.counts = Counts{}
+	c.generation++
+	c.probing = false
+	return transition{from, to}
+}
+
+func (c *circuitBreaker[T]) notify(changes *[]transition) {
+	for _, change := range *changes {
+		c.onChange(change.from, change.to)
+	}
+}
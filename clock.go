@@ -0,0 +1,57 @@
+package property
+
+import "time"
+
+// Clock tells the current time, decorators that depend on time receive
+// it as an argument so they can be tested without sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock returns implementation of Clock interface backed by time.Now.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// Scheduler is a Clock which can also run a function later, decorators
+// that delay work receive it as an argument so they can be tested without
+// sleeping.
+type Scheduler interface {
+	Clock
+	// AfterFunc runs f in its own goroutine after d, the returned stop
+	// function cancels f and reports whether it was cancelled before running.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+// SystemScheduler returns implementation of Scheduler interface
+// backed by time.AfterFunc.
+func SystemScheduler() Scheduler {
+	return systemClock{}
+}
+
+// Sleeper pauses the current goroutine, decorators that wait between
+// attempts receive it as an argument so they can be tested without sleeping.
+type Sleeper interface {
+	Sleep(time.Duration)
+}
+
+// SystemSleeper returns implementation of Sleeper interface backed by time.Sleep.
+func SystemSleeper() Sleeper {
+	return systemSleeper{}
+}
+
+type systemSleeper struct{}
+
+func (systemSleeper) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
@@ -0,0 +1,80 @@
+package property
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrConflict is returned by CompareAndChange when current value
+// does not match the expected one.
+var ErrConflict = errors.New("property: conflict")
+
+// CompareAndChanger is implemented by properties which support
+// optimistic concurrency.
+type CompareAndChanger[T any] interface {
+	Property[T]
+	// CompareAndChange changes value only if current value equals
+	// expected, otherwise it returns ErrConflict.
+	CompareAndChange(expected, value T) error
+}
+
+// CompareAndChange returns implementation of CompareAndChanger[T comparable]
+// interface, it is safe for concurrent use.
+//
+// Check and change are atomic only among writers which share this decorator,
+// see Versioned when other processes write to the same datasource.
+//
+// # Panic when property argument is nil
+func CompareAndChange[T comparable](property Property[T]) *compareAndChange[T] {
+	if property == nil {
+		panic("property.CompareAndChange: cannot be created from nil property")
+	}
+	return &compareAndChange[T]{
+		property: property,
+	}
+}
+
+// compareAndChange implements CompareAndChanger[T comparable] interface
+type compareAndChange[T comparable] struct {
+	mu       sync.Mutex
+	property Property[T]
+}
+
+// Change message delegates to underlying property unconditionally.
+//
+// Error of underlying property is returned.
+func (c *compareAndChange[T]) Change(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.property.Change(value)
+}
+
+// Value message returns actual value by delegation to underlying property.
+func (c *compareAndChange[T]) Value() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.property.Value()
+}
+
+// CompareAndChange message reads current value of underlying property and
+// changes it only if it equals expected. When underlying property reports
+// ErrNotFound the zero value of T is its current value, so a value which
+// has never been written is changed by expecting zero.
+//
+// ErrConflict or error of underlying property is returned.
+func (c *compareAndChange[T]) CompareAndChange(expected, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current, err := c.property.Value()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		var zero T
+		current = zero
+	}
+	if current != expected {
+		return ErrConflict
+	}
+	return c.property.Change(value)
+}
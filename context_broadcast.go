@@ -0,0 +1,46 @@
+package property
+
+import "context"
+
+// ContextBroadcast is the context-aware variant of Broadcast, receivers
+// get the context of Change message along with the new value.
+//
+// panic when:
+//   - receivers is empty.
+//   - property is nil.
+func ContextBroadcast[T any](receivers []func(context.Context, T), property ContextProperty[T]) *contextBroadcast[T] {
+	if len(receivers) == 0 {
+		panic("property.ContextBroadcast: cannot be created with zero receivers")
+	}
+	if property == nil {
+		panic("property.ContextBroadcast: cannot be created from nil property")
+	}
+	return &contextBroadcast[T]{
+		receivers: receivers,
+		property:  property,
+	}
+}
+
+type contextBroadcast[T any] struct {
+	receivers []func(context.Context, T)
+	property  ContextProperty[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, receivers get notified with the same value.
+//
+// Error of underlying property is returned.
+func (b contextBroadcast[T]) Change(ctx context.Context, value T) error {
+	if err := b.property.Change(ctx, value); err != nil {
+		return err
+	}
+	for _, fx := range b.receivers {
+		fx(ctx, value)
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+func (b contextBroadcast[T]) Value(ctx context.Context) (T, error) {
+	return b.property.Value(ctx)
+}
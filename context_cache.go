@@ -0,0 +1,47 @@
+package property
+
+import "context"
+
+// ContextCache is the context-aware variant of Cache.
+//
+// messages:
+//   - Change updates underlying property and in memory value if no error occur.
+//   - Value  returns in-memory cached value and nil, context is ignored.
+//
+// Unlike Cache it does not implement Refresher, since Refresh would need a
+// context to re-read underlying property; create a new ContextCache instead.
+//
+// # Panic when property argument is nil
+func ContextCache[T any](value T, property ContextProperty[T]) *contextCache[T] {
+	if property == nil {
+		panic("property.ContextCache: cannot be created from nil property")
+	}
+	return &contextCache[T]{
+		value:    value,
+		property: property,
+	}
+}
+
+// contextCache implements ContextProperty[T any] interface
+type contextCache[T any] struct {
+	value    T
+	property ContextProperty[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *contextCache[T]) Change(ctx context.Context, value T) error {
+	err := c.property.Change(ctx, value)
+	if err == nil {
+		c.value = value
+	}
+	return err
+}
+
+// Value message returns in-memory cached value and nil.
+// No delegation occurs to underlying property.
+func (c *contextCache[T]) Value(context.Context) (T, error) {
+	return c.value, nil
+}
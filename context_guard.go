@@ -0,0 +1,38 @@
+package property
+
+import "context"
+
+// ContextGuard is the context-aware variant of Guard, value is evaluated
+// by constraint before delegation to underlying property.
+//
+// panic when:
+//   - cons is nil.
+//   - property is nil.
+func ContextGuard[T any](cons Constraint[T], property ContextProperty[T]) contextGuard[T] {
+	if cons == nil {
+		panic("property.ContextGuard: cannot be created from nil constraint")
+	}
+	if property == nil {
+		panic("property.ContextGuard: cannot be created from nil property")
+	}
+	return contextGuard[T]{
+		constraint: cons,
+		property:   property,
+	}
+}
+
+type contextGuard[T any] struct {
+	constraint Constraint[T]
+	property   ContextProperty[T]
+}
+
+func (g contextGuard[T]) Change(ctx context.Context, value T) error {
+	if err := g.constraint.Evaluate(value); err != nil {
+		return err
+	}
+	return g.property.Change(ctx, value)
+}
+
+func (g contextGuard[T]) Value(ctx context.Context) (T, error) {
+	return g.property.Value(ctx)
+}
@@ -0,0 +1,40 @@
+package property
+
+import (
+	"context"
+	"errors"
+)
+
+// ContextInequality is the context-aware variant of Inequality, Change
+// is delegated only when value differs from the current one, or when
+// underlying property reports ErrNotFound.
+//
+// # Panic when property argument is nil
+func ContextInequality[T comparable](property ContextProperty[T]) contextInequality[T] {
+	if property == nil {
+		panic("property.ContextInequality: cannot be created from nil property")
+	}
+	return contextInequality[T]{property}
+}
+
+type contextInequality[T comparable] struct {
+	property ContextProperty[T]
+}
+
+func (i contextInequality[T]) Change(ctx context.Context, value T) error {
+	old, err := i.Value(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return i.property.Change(ctx, value)
+	}
+	if err != nil {
+		return err
+	}
+	if old == value {
+		return nil
+	}
+	return i.property.Change(ctx, value)
+}
+
+func (i contextInequality[T]) Value(ctx context.Context) (T, error) {
+	return i.property.Value(ctx)
+}
@@ -0,0 +1,45 @@
+package property
+
+import "context"
+
+// ContextLazyCache is the context-aware variant of LazyCache, value is
+// loaded with the context of the first Value message. A load that fails,
+// for instance because its context is cancelled, is not cached.
+//
+// Unlike LazyCache it does not implement Refresher, since Refresh would need
+// a context to re-read underlying property; create a new ContextLazyCache
+// instead.
+//
+// # Panic when property argument is nil
+func ContextLazyCache[T any](property ContextProperty[T]) *contextLazyCache[T] {
+	if property == nil {
+		panic("property.ContextLazyCache: cannot be created from nil property")
+	}
+	return &contextLazyCache[T]{
+		property: property,
+	}
+}
+
+type contextLazyCache[T any] struct {
+	value    *T
+	property ContextProperty[T]
+}
+
+func (c *contextLazyCache[T]) Change(ctx context.Context, value T) error {
+	err := c.property.Change(ctx, value)
+	if err == nil {
+		c.value = &value
+	}
+	return err
+}
+
+func (c *contextLazyCache[T]) Value(ctx context.Context) (T, error) {
+	if c.value != nil {
+		return *c.value, nil
+	}
+	value, err := c.property.Value(ctx)
+	if err == nil {
+		c.value = &value
+	}
+	return value, err
+}
@@ -0,0 +1,73 @@
+package property
+
+import "context"
+
+// ContextProperty is the context-aware variant of Property, context carries
+// cancellation, deadlines and request-scoped data to the datasource.
+type ContextProperty[T any] interface {
+	Change(context.Context, T) error
+	Value(context.Context) (T, error)
+}
+
+// WithContext adapts Property[T any] to ContextProperty[T any] interface.
+// Since property cannot be interrupted, context is only checked before
+// delegation, error of a done context is returned without delegation.
+//
+// # Panic when property argument is nil
+func WithContext[T any](property Property[T]) withContext[T] {
+	if property == nil {
+		panic("property.WithContext: cannot be created from nil property")
+	}
+	return withContext[T]{property}
+}
+
+type withContext[T any] struct {
+	property Property[T]
+}
+
+func (w withContext[T]) Change(ctx context.Context, value T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return w.property.Change(value)
+}
+
+func (w withContext[T]) Value(ctx context.Context) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+	return w.property.Value()
+}
+
+// WithoutContext adapts ContextProperty[T any] to Property[T any] interface,
+// every message is delegated with ctx.
+//
+// panic when:
+//   - ctx is nil.
+//   - property is nil.
+func WithoutContext[T any](ctx context.Context, property ContextProperty[T]) withoutContext[T] {
+	if ctx == nil {
+		panic("property.WithoutContext: cannot be created from nil context")
+	}
+	if property == nil {
+		panic("property.WithoutContext: cannot be created from nil property")
+	}
+	return withoutContext[T]{
+		ctx:      ctx,
+		property: property,
+	}
+}
+
+type withoutContext[T any] struct {
+	ctx      context.Context
+	property ContextProperty[T]
+}
+
+func (w withoutContext[T]) Change(value T) error {
+	return w.property.Change(w.ctx, value)
+}
+
+func (w withoutContext[T]) Value() (T, error) {
+	return w.property.Value(w.ctx)
+}
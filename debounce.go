@@ -0,0 +1,135 @@
+package property
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce implements Property[T any] interface which delays Change until
+// no other Change is received for d, only the last value is sent to
+// underlying property. It is safe for concurrent use.
+//
+// messages:
+//   - Change keeps value as pending, returns nil and restarts the quiet period.
+//   - Value  returns pending value and nil if any, otherwise it delegates to
+//     underlying property.
+//   - Flush  sends pending value to underlying property right away.
+//
+// Errors of delayed writes are reported to errs after the internal lock is
+// released, so errs may send messages to the decorator. A value whose write
+// failed stays pending, Value keeps returning it and the write is retried
+// after d unless a newer Change replaces it.
+//
+// panic when:
+//   - d is not positive.
+//   - scheduler is nil.
+//   - errs is nil.
+//   - property is nil.
+func Debounce[T any](d time.Duration, scheduler Scheduler, errs func(error), property Property[T]) *debounce[T] {
+	if d <= 0 {
+		panic("property.Debounce: cannot be created with non-positive duration")
+	}
+	if scheduler == nil {
+		panic("property.Debounce: cannot be created from nil scheduler")
+	}
+	if errs == nil {
+		panic("property.Debounce: cannot be created from nil errs")
+	}
+	if property == nil {
+		panic("property.Debounce: cannot be created from nil property")
+	}
+	return &debounce[T]{
+		d:         d,
+		scheduler: scheduler,
+		errs:      errs,
+		property:  property,
+	}
+}
+
+// debounce implements Property[T any] interface
+type debounce[T any] struct {
+	mu        sync.Mutex
+	d         time.Duration
+	scheduler Scheduler
+	errs      func(error)
+	pending   *T
+	stop      func() bool
+	// generation tells a fired timer whether it is still the current one
+	generation uint64
+	property   Property[T]
+}
+
+// Change message keeps value as pending and restarts the quiet period,
+// it always returns nil.
+func (d *debounce[T]) Change(value T) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pending = &value
+	d.schedule()
+	return nil
+}
+
+// Value message returns pending value and nil if any,
+// otherwise it delegates to underlying property.
+func (d *debounce[T]) Value() (T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending != nil {
+		return *d.pending, nil
+	}
+	return d.property.Value()
+}
+
+// Flush message sends pending value to underlying property right away.
+//
+// Error of underlying property is returned, the value stays pending.
+func (d *debounce[T]) Flush() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		d.stop()
+		d.stop = nil
+	}
+	d.generation++
+	return d.write()
+}
+
+func (d *debounce[T]) fire(generation uint64) {
+	d.mu.Lock()
+	if generation != d.generation {
+		d.mu.Unlock()
+		return
+	}
+	d.stop = nil
+	err := d.write()
+	d.mu.Unlock()
+	if err != nil {
+		d.errs(err)
+	}
+}
+
+// schedule must be called while holding mu.
+func (d *debounce[T]) schedule() {
+	if d.stop != nil {
+		d.stop()
+	}
+	d.generation++
+	generation := d.generation
+	d.stop = d.scheduler.AfterFunc(d.d, func() {
+		d.fire(generation)
+	})
+}
+
+// write must be called while holding mu, pending value is kept
+// and a retry is scheduled when underlying property fails.
+func (d *debounce[T]) write() error {
+	if d.pending == nil {
+		return nil
+	}
+	if err := d.property.Change(*d.pending); err != nil {
+		d.schedule()
+		return err
+	}
+	d.pending = nil
+	return nil
+}
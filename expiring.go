@@ -0,0 +1,112 @@
+package property
+
+import (
+	"sync"
+	"time"
+)
+
+// Expiring implements Property[T any] interface which saves value in memory
+// for a limited duration (ttl), it is safe for concurrent use.
+//
+// messages:
+//   - Change updates underlying property and in memory value if no error occur,
+//     the ttl starts again.
+//   - Value  returns in-memory cached value while it is younger than ttl,
+//     otherwise it delegates to underlying property and caches the result
+//     if no error occur.
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh re-reads underlying property, the ttl starts again.
+//
+// Expiring is useful when underlying datasource may be changed by another
+// process, where Cache and LazyCache would keep the old value forever.
+//
+// panic when:
+//   - ttl is not positive.
+//   - clock is nil.
+//   - property is nil.
+func Expiring[T any](ttl time.Duration, clock Clock, property Property[T]) *expiring[T] {
+	if ttl <= 0 {
+		panic("property.Expiring: cannot be created with non-positive ttl")
+	}
+	if clock == nil {
+		panic("property.Expiring: cannot be created from nil clock")
+	}
+	if property == nil {
+		panic("property.Expiring: cannot be created from nil property")
+	}
+	return &expiring[T]{
+		ttl:      ttl,
+		clock:    clock,
+		property: property,
+	}
+}
+
+// expiring implements Property[T any] interface
+type expiring[T any] struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	clock    Clock
+	value    *T
+	expiry   time.Time
+	property Property[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, in-memory cached value will be updated and
+// expires after ttl.
+//
+// Error of underlying property is returned.
+func (e *expiring[T]) Change(value T) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	err := e.property.Change(value)
+	if err == nil {
+		e.value = &value
+		e.expiry = e.clock.Now().Add(e.ttl)
+	}
+	return err
+}
+
+// Value message returns in-memory cached value and nil when it has not
+// expired yet, otherwise it delegates to underlying property. On error
+// the expired value is dropped so the next Value delegates again.
+//
+// Error of underlying property is returned.
+func (e *expiring[T]) Value() (T, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.value != nil && e.clock.Now().Before(e.expiry) {
+		return *e.value, nil
+	}
+	value, err := e.property.Value()
+	if err != nil {
+		e.value = nil
+		return value, err
+	}
+	e.value = &value
+	e.expiry = e.clock.Now().Add(e.ttl)
+	return value, nil
+}
+
+// Invalidate message empties the cache, next Value delegates
+// to underlying property.
+func (e *expiring[T]) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.value = nil
+}
+
+// Refresh message delegates to underlying property, when no error occur
+// in-memory cached value will be updated and expires after ttl.
+//
+// Error of underlying property is returned.
+func (e *expiring[T]) Refresh() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	value, err := e.property.Value()
+	if err == nil {
+		e.value = &value
+		e.expiry = e.clock.Now().Add(e.ttl)
+	}
+	return err
+}
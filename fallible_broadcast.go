@@ -0,0 +1,111 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReceiverError is returned by FallibleBroadcast when a receiver fails.
+type ReceiverError struct {
+	// Receiver is the index of the failed receiver.
+	Receiver int
+	// Err is the error of the failed receiver.
+	Err error
+	// Compensation holds errors of compensating calls and of reverting
+	// underlying property, it is nil when compensation succeeded.
+	Compensation error
+}
+
+func (e *ReceiverError) Error() string {
+	if e.Compensation == nil {
+		return fmt.Sprintf("property.FallibleBroadcast: receiver %d: %v", e.Receiver, e.Err)
+	}
+	return fmt.Sprintf("property.FallibleBroadcast: receiver %d: %v; compensation: %v", e.Receiver, e.Err, e.Compensation)
+}
+
+// Unwrap returns both the error of the failed receiver and compensation
+// errors, so errors.Is and errors.As match either of them.
+func (e *ReceiverError) Unwrap() []error {
+	if e.Compensation == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Err, e.Compensation}
+}
+
+// FallibleBroadcast returns implementation of Property[T any] interface.
+// it is the variant of Broadcast whose receivers can fail, such as
+// a foreign-key cascade.
+//
+// Old value is read through underlying property before the change. When
+// receiver k fails, receivers k-1..0 get a compensating call with the old
+// value (in reverse order), underlying property is changed back to the old
+// value and *ReceiverError is returned. The zero value of T is used as old
+// value when underlying property reports ErrNotFound.
+//
+// panic when:
+//   - receivers is empty.
+//   - property is nil.
+//   - recivers has nil function (default panic message).
+func FallibleBroadcast[T any](receivers []func(T) error, property Property[T]) *fallibleBroadcast[T] {
+	if len(receivers) == 0 {
+		panic("property.FallibleBroadcast: cannot be created with zero receivers")
+	}
+	if property == nil {
+		panic("property.FallibleBroadcast: cannot be created from nil property")
+	}
+	return &fallibleBroadcast[T]{
+		receivers: receivers,
+		property:  property,
+	}
+}
+
+type fallibleBroadcast[T any] struct {
+	receivers []func(T) error
+	property  Property[T]
+}
+
+// Change message reads old value and delegates to underlying property to
+// update itself, when no error occur receivers get notified in order.
+//
+// Error of underlying property or *ReceiverError is returned.
+func (b fallibleBroadcast[T]) Change(value T) error {
+	old, err := b.property.Value()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := b.property.Change(value); err != nil {
+		return err
+	}
+	for k, fx := range b.receivers {
+		if err := fx(value); err != nil {
+			return &ReceiverError{
+				Receiver:     k,
+				Err:          err,
+				Compensation: b.compensate(k, old),
+			}
+		}
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (b fallibleBroadcast[T]) Value() (T, error) {
+	return b.property.Value()
+}
+
+// compensate notifies receivers before k with old value in reverse order
+// and reverts underlying property, errors are returned joined together.
+func (b fallibleBroadcast[T]) compensate(k int, old T) error {
+	var errs []error
+	for j := k - 1; j >= 0; j-- {
+		if err := b.receivers[j](old); err != nil {
+			errs = append(errs, fmt.Errorf("receiver %d: %w", j, err))
+		}
+	}
+	if err := b.property.Change(old); err != nil {
+		errs = append(errs, fmt.Errorf("property: %w", err))
+	}
+	return errors.Join(errs...)
+}
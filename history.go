@@ -0,0 +1,113 @@
+package property
+
+import "errors"
+
+// ErrNoHistory is returned by Undo and Redo when there is nothing to restore.
+var ErrNoHistory = errors.New("property: no history")
+
+// History implements Property[T any] interface which keeps the last n
+// successful values of underlying property so they can be restored.
+//
+// messages:
+//   - Change delegates to underlying property, on success value is recorded
+//     and the redo stack is discarded. The first Change also records current
+//     value of underlying property, if it can be read, so it can be undone.
+//   - Value  delegates to underlying property.
+//   - Undo   changes underlying property back to the previous value.
+//   - Redo   changes underlying property to the value undone last.
+//   - History returns a copy of recorded values, oldest first.
+//
+// Undo and Redo send Change to underlying property, so constraints of a Guard
+// and receivers of a Broadcast in the chain still run.
+//
+// # Panic when n is less than 2 or property is nil
+func History[T any](n int, property Property[T]) *history[T] {
+	if n < 2 {
+		panic("property.History: cannot be created with less than two values")
+	}
+	if property == nil {
+		panic("property.History: cannot be created from nil property")
+	}
+	return &history[T]{
+		n:        n,
+		property: property,
+	}
+}
+
+// history implements Property[T any] interface
+type history[T any] struct {
+	n int
+	// past holds recorded values, the last one is current
+	past []T
+	// future holds undone values, the last one is redone first
+	future   []T
+	property Property[T]
+}
+
+// Change message delegates to underlying property, when no error occur
+// value is recorded and values which were undone are forgotten.
+//
+// Error of underlying property is returned.
+func (h *history[T]) Change(value T) error {
+	if len(h.past) == 0 {
+		if old, err := h.property.Value(); err == nil {
+			h.record(old)
+		}
+	}
+	if err := h.property.Change(value); err != nil {
+		return err
+	}
+	h.record(value)
+	h.future = nil
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+func (h *history[T]) Value() (T, error) {
+	return h.property.Value()
+}
+
+// Undo message changes underlying property to the previous recorded value.
+//
+// ErrNoHistory or error of underlying property is returned.
+func (h *history[T]) Undo() error {
+	if len(h.past) < 2 {
+		return ErrNoHistory
+	}
+	last := len(h.past) - 1
+	if err := h.property.Change(h.past[last-1]); err != nil {
+		return err
+	}
+	h.future = append(h.future, h.past[last])
+	h.past = h.past[:last]
+	return nil
+}
+
+// Redo message changes underlying property to the value undone last.
+//
+// ErrNoHistory or error of underlying property is returned.
+func (h *history[T]) Redo() error {
+	if len(h.future) == 0 {
+		return ErrNoHistory
+	}
+	last := len(h.future) - 1
+	if err := h.property.Change(h.future[last]); err != nil {
+		return err
+	}
+	h.record(h.future[last])
+	h.future = h.future[:last]
+	return nil
+}
+
+// History message returns a copy of recorded values, oldest first
+// and current value last.
+func (h *history[T]) History() []T {
+	return append([]T(nil), h.past...)
+}
+
+func (h *history[T]) record(value T) {
+	h.past = append(h.past, value)
+	if len(h.past) > h.n {
+		h.past = append(h.past[:0:0], h.past[len(h.past)-h.n:]...)
+	}
+}
@@ -1,5 +1,7 @@
 package property
 
+import "errors"
+
 func Inequality[T comparable](property Property[T]) inequality[T] {
 	return inequality[T]{property}
 }
@@ -8,8 +10,14 @@ type inequality[T comparable] struct {
 	property Property[T]
 }
 
+// Change message delegates to underlying property unless value equals
+// the current one, a property reporting ErrNotFound has no current value
+// so Change is delegated.
 func (i inequality[T]) Change(value T) error {
 	old, err := i.Value()
+	if errors.Is(err, ErrNotFound) {
+		return i.property.Change(value)
+	}
 	if err != nil {
 		return err
 	}
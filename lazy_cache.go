@@ -1,5 +1,23 @@
 package property
 
+// LazyCache implements Property[T any] interface which provides the
+// ability to save value in memory.
+// LazyCache represents lazy loading since value is loaded from underlying
+// property by the first Value message.
+//
+// messages:
+//   - Change updates underlying property and in memory value if no error occur.
+//   - Value  returns in-memory cached value, otherwise it delegates to
+//     underlying property and caches the value if no error occur.
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh re-reads underlying property.
+//
+// LazyCache is not safe for concurrent use, see SyncLazyCache which also
+// deduplicates concurrent loads. See NegativeCache to back off underlying
+// property after load errors, and WithDefault for ErrNotFound which, like
+// any error, is not cached.
+//
+// # Panic when property argument is nil
 func LazyCache[T any](property Property[T]) *lazyCache[T] {
 	if property == nil {
 		panic("property.LazyCache: cannot be created from nil property")
@@ -32,3 +50,15 @@ func (c *lazyCache[T]) Value() (T, error) {
 	}
 	return value, err
 }
+
+func (c *lazyCache[T]) Invalidate() {
+	c.value = nil
+}
+
+func (c *lazyCache[T]) Refresh() error {
+	value, err := c.property.Value()
+	if err == nil {
+		c.value = &value
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package property
+
+// Map returns implementation of Property[B any] interface on top of
+// Property[A any], values are converted by to when read and by from
+// when written.
+//
+// Conversion errors are returned by Value and Change, a value that
+// cannot be converted is never sent to underlying property.
+//
+// panic when:
+//   - property is nil.
+//   - to is nil.
+//   - from is nil.
+func Map[A, B any](property Property[A], to func(A) (B, error), from func(B) (A, error)) mapped[A, B] {
+	if property == nil {
+		panic("property.Map: cannot be created from nil property")
+	}
+	if to == nil {
+		panic("property.Map: cannot be created from nil to")
+	}
+	if from == nil {
+		panic("property.Map: cannot be created from nil from")
+	}
+	return mapped[A, B]{
+		property: property,
+		to:       to,
+		from:     from,
+	}
+}
+
+type mapped[A, B any] struct {
+	property Property[A]
+	to       func(A) (B, error)
+	from     func(B) (A, error)
+}
+
+// Change message converts value by from and delegates
+// to underlying property.
+//
+// Error of conversion or of underlying property is returned.
+func (m mapped[A, B]) Change(value B) error {
+	a, err := m.from(value)
+	if err != nil {
+		return err
+	}
+	return m.property.Change(a)
+}
+
+// Value message delegates to underlying property and converts
+// its value by to.
+//
+// Error of underlying property or of conversion is returned.
+func (m mapped[A, B]) Value() (B, error) {
+	a, err := m.property.Value()
+	if err != nil {
+		var zero B
+		return zero, err
+	}
+	return m.to(a)
+}
+
+// Focus returns implementation of Property[F any] interface which exposes
+// a single field of a struct held by Property[S any]. Value reads the whole
+// struct and returns the field by get, Change reads the whole struct, sets
+// the field on a copy by set and writes the copy back.
+//
+// Read-modify-write is not atomic, see Synchronized or CompareAndChange
+// when several writers share the struct.
+//
+// panic when:
+//   - property is nil.
+//   - get is nil.
+//   - set is nil.
+func Focus[S, F any](property Property[S], get func(S) F, set func(S, F) S) focus[S, F] {
+	if property == nil {
+		panic("property.Focus: cannot be created from nil property")
+	}
+	if get == nil {
+		panic("property.Focus: cannot be created from nil get")
+	}
+	if set == nil {
+		panic("property.Focus: cannot be created from nil set")
+	}
+	return focus[S, F]{
+		property: property,
+		get:      get,
+		set:      set,
+	}
+}
+
+type focus[S, F any] struct {
+	property Property[S]
+	get      func(S) F
+	set      func(S, F) S
+}
+
+// Change message reads the whole struct, sets the field and writes
+// the modified copy to underlying property.
+//
+// Error of underlying property is returned.
+func (f focus[S, F]) Change(value F) error {
+	s, err := f.property.Value()
+	if err != nil {
+		return err
+	}
+	return f.property.Change(f.set(s, value))
+}
+
+// Value message reads the whole struct and returns the field.
+//
+// Error of underlying property is returned.
+func (f focus[S, F]) Value() (F, error) {
+	s, err := f.property.Value()
+	if err != nil {
+		var zero F
+		return zero, err
+	}
+	return f.get(s), nil
+}
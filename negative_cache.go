@@ -0,0 +1,116 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrBackoff is wrapped together with the remembered error by NegativeCache
+// when Value is received during the backoff window.
+var ErrBackoff = errors.New("property: load error is cached until backoff ends")
+
+// NegativeCache implements Property[T any] interface which remembers the last
+// error of underlying Value, it is safe for concurrent use.
+//
+// It is meant to be placed under LazyCache (or SyncLazyCache) which retries
+// underlying property on every Value after a failure, so a broken datasource
+// does not get hammered:
+//
+//	property.LazyCache[T](property.NegativeCache[T](time.Second, time.Minute, clock, datasource))
+//
+// messages:
+//   - Change delegates to underlying property, on success backoff is reset.
+//   - Value  delegates to underlying property. When it fails, the error is
+//     remembered for a window that starts at initial and doubles on every
+//     consecutive failure up to max. Value received within the window returns
+//     the remembered error wrapped with ErrBackoff without delegation, so both
+//     errors.Is(err, ErrBackoff) and errors.Is(err, original) hold. A successful
+//     Value resets the backoff.
+//
+// panic when:
+//   - initial is not positive or max is less than initial.
+//   - clock is nil.
+//   - property is nil.
+func NegativeCache[T any](initial, max time.Duration, clock Clock, property Property[T]) *negativeCache[T] {
+	if initial <= 0 || max < initial {
+		panic("property.NegativeCache: cannot be created unless 0 < initial <= max")
+	}
+	if clock == nil {
+		panic("property.NegativeCache: cannot be created from nil clock")
+	}
+	if property == nil {
+		panic("property.NegativeCache: cannot be created from nil property")
+	}
+	return &negativeCache[T]{
+		initial:  initial,
+		max:      max,
+		clock:    clock,
+		property: property,
+	}
+}
+
+// negativeCache implements Property[T any] interface
+type negativeCache[T any] struct {
+	mu       sync.Mutex
+	initial  time.Duration
+	max      time.Duration
+	clock    Clock
+	err      error
+	window   time.Duration
+	until    time.Time
+	property Property[T]
+}
+
+// Change message delegates to underlying property, when no error occur
+// the remembered error is forgotten.
+//
+// Error of underlying property is returned.
+func (n *negativeCache[T]) Change(value T) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	err := n.property.Change(value)
+	if err == nil {
+		n.reset()
+	}
+	return err
+}
+
+// Value message returns the remembered error wrapped with ErrBackoff during
+// the backoff window, otherwise it delegates to underlying property.
+//
+// Error of underlying property is returned.
+func (n *negativeCache[T]) Value() (T, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err != nil && n.clock.Now().Before(n.until) {
+		var zero T
+		return zero, fmt.Errorf("%w: %w", ErrBackoff, n.err)
+	}
+	value, err := n.property.Value()
+	if err != nil {
+		n.remember(err)
+		return value, err
+	}
+	n.reset()
+	return value, nil
+}
+
+func (n *negativeCache[T]) remember(err error) {
+	switch {
+	case n.window == 0:
+		n.window = n.initial
+	case n.window < n.max/2:
+		n.window *= 2
+	default:
+		n.window = n.max
+	}
+	n.err = err
+	n.until = n.clock.Now().Add(n.window)
+}
+
+func (n *negativeCache[T]) reset() {
+	n.err = nil
+	n.window = 0
+}
@@ -1,6 +1,16 @@
 package property
 
-type Property[T any] interface {
-	Change(T) error
+// Reader is the read capability of a property.
+type Reader[T any] interface {
 	Value() (T, error)
 }
+
+// Writer is the write capability of a property.
+type Writer[T any] interface {
+	Change(T) error
+}
+
+type Property[T any] interface {
+	Writer[T]
+	Reader[T]
+}
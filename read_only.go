@@ -0,0 +1,63 @@
+package property
+
+import "errors"
+
+// ErrReadOnly is returned by Change of a property created by ReadOnly.
+var ErrReadOnly = errors.New("property: read-only")
+
+// ErrWriteOnly is returned by Value of a property created by WriteOnly.
+var ErrWriteOnly = errors.New("property: write-only")
+
+// ReadOnly returns implementation of Property[T any] interface on top of
+// Reader[T any], Value delegates to reader and Change always returns
+// ErrReadOnly. It lets an API take a Property while its client only
+// grants read access.
+//
+// # Panic when reader is nil
+func ReadOnly[T any](reader Reader[T]) readOnly[T] {
+	if reader == nil {
+		panic("property.ReadOnly: cannot be created from nil reader")
+	}
+	return readOnly[T]{reader}
+}
+
+type readOnly[T any] struct {
+	reader Reader[T]
+}
+
+// Change message always returns ErrReadOnly.
+func (readOnly[T]) Change(T) error {
+	return ErrReadOnly
+}
+
+// Value message returns actual value by delegation to reader.
+func (r readOnly[T]) Value() (T, error) {
+	return r.reader.Value()
+}
+
+// WriteOnly returns implementation of Property[T any] interface on top of
+// Writer[T any], Change delegates to writer and Value always returns
+// ErrWriteOnly. It is meant for secrets which must never be read back.
+//
+// # Panic when writer is nil
+func WriteOnly[T any](writer Writer[T]) writeOnly[T] {
+	if writer == nil {
+		panic("property.WriteOnly: cannot be created from nil writer")
+	}
+	return writeOnly[T]{writer}
+}
+
+type writeOnly[T any] struct {
+	writer Writer[T]
+}
+
+// Change message delegates to writer.
+func (w writeOnly[T]) Change(value T) error {
+	return w.writer.Change(value)
+}
+
+// Value message always returns zero value and ErrWriteOnly.
+func (writeOnly[T]) Value() (T, error) {
+	var zero T
+	return zero, ErrWriteOnly
+}
@@ -0,0 +1,14 @@
+package property
+
+// Refresher is implemented by decorators which keep value in memory,
+// it lets client resynchronize them after an out-of-band write to
+// the underlying datasource.
+//
+// messages:
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh    re-reads underlying property and caches the value if no
+//     error occur, on error the cache is left as it is.
+type Refresher interface {
+	Invalidate()
+	Refresh() error
+}
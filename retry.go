@@ -0,0 +1,126 @@
+package property
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryPolicy decides whether and when Retry sends a failed message again.
+type RetryPolicy interface {
+	// Attempts returns the maximum number of attempts including the first one.
+	Attempts() int
+	// Transient reports whether err is worth another attempt.
+	Transient(err error) bool
+	// Delay returns how long to wait before the given retry, first retry is 1.
+	Delay(retry int) time.Duration
+}
+
+// Policy returns implementation of RetryPolicy interface.
+//
+// panic when:
+//   - attempts is less than 1.
+//   - transient is nil.
+//   - backoff is nil.
+func Policy(attempts int, transient func(error) bool, backoff Backoff) RetryPolicy {
+	if attempts < 1 {
+		panic("property.Policy: cannot be created with less than one attempt")
+	}
+	if transient == nil {
+		panic("property.Policy: cannot be created from nil transient")
+	}
+	if backoff == nil {
+		panic("property.Policy: cannot be created from nil backoff")
+	}
+	return policy{
+		attempts:  attempts,
+		transient: transient,
+		backoff:   backoff,
+	}
+}
+
+type policy struct {
+	attempts  int
+	transient func(error) bool
+	backoff   Backoff
+}
+
+func (p policy) Attempts() int {
+	return p.attempts
+}
+
+func (p policy) Transient(err error) bool {
+	return p.transient(err)
+}
+
+func (p policy) Delay(retry int) time.Duration {
+	return p.backoff(retry)
+}
+
+// Retry implements Property[T any] interface which sends a failed message
+// to underlying property again, as long as policy considers the error
+// transient and attempts are not exhausted. sleeper waits between attempts.
+//
+// When a single attempt is made its error is returned as it is, otherwise
+// errors of every attempt are returned joined together (see errors.Join),
+// so errors.Is and errors.As keep working.
+//
+// panic when:
+//   - policy is nil.
+//   - sleeper is nil.
+//   - property is nil.
+func Retry[T any](policy RetryPolicy, sleeper Sleeper, property Property[T]) retry[T] {
+	if policy == nil {
+		panic("property.Retry: cannot be created from nil policy")
+	}
+	if sleeper == nil {
+		panic("property.Retry: cannot be created from nil sleeper")
+	}
+	if property == nil {
+		panic("property.Retry: cannot be created from nil property")
+	}
+	return retry[T]{
+		policy:   policy,
+		sleeper:  sleeper,
+		property: property,
+	}
+}
+
+// retry implements Property[T any] interface
+type retry[T any] struct {
+	policy   RetryPolicy
+	sleeper  Sleeper
+	property Property[T]
+}
+
+// Change message delegates to underlying property until it succeeds
+// or policy gives up.
+func (r retry[T]) Change(value T) error {
+	_, err := attempt(r, func() (struct{}, error) {
+		return struct{}{}, r.property.Change(value)
+	})
+	return err
+}
+
+// Value message delegates to underlying property until it succeeds
+// or policy gives up.
+func (r retry[T]) Value() (T, error) {
+	return attempt(r, r.property.Value)
+}
+
+func attempt[T, R any](r retry[T], fx func() (R, error)) (R, error) {
+	var errs []error
+	for i := 1; ; i++ {
+		result, err := fx()
+		if err == nil {
+			return result, nil
+		}
+		errs = append(errs, err)
+		if i >= r.policy.Attempts() || !r.policy.Transient(err) {
+			if len(errs) == 1 {
+				return result, err
+			}
+			return result, errors.Join(errs...)
+		}
+		r.sleeper.Sleep(r.policy.Delay(i))
+	}
+}
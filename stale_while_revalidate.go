@@ -0,0 +1,182 @@
+package property
+
+import (
+	"sync"
+	"time"
+)
+
+// StaleWhileRevalidate implements Property[T any] interface which keeps
+// value in memory and refreshes it in the background, it is safe for
+// concurrent use and is meant for slow underlying properties.
+//
+// It follows LazyCache contract, first Value loads from underlying property
+// and Change writes through and caches the value if no error occur. The age
+// of cached value decides how Value behaves:
+//   - younger than soft: cached value is returned.
+//   - between soft and hard: cached value is returned right away and a
+//     background refresh is requested (refresh-ahead), so callers keep
+//     reading a fresh value without waiting on underlying property.
+//   - older than hard: Value blocks and delegates to underlying property.
+//
+// Callers which block wait for each other, the first one loads and the
+// others get its value instead of loading again. Errors of background
+// refreshes are reported to errs, after internal locks are released, and
+// leave the cached value as it is. Close stops the refresher goroutine,
+// afterwards Value keeps working but never refreshes in the background.
+//
+// panic when:
+//   - soft is not positive or hard is less than soft.
+//   - clock is nil.
+//   - errs is nil.
+//   - property is nil.
+func StaleWhileRevalidate[T any](soft, hard time.Duration, clock Clock, errs func(error), property Property[T]) *staleWhileRevalidate[T] {
+	if soft <= 0 || hard < soft {
+		panic("property.StaleWhileRevalidate: cannot be created unless 0 < soft <= hard")
+	}
+	if clock == nil {
+		panic("property.StaleWhileRevalidate: cannot be created from nil clock")
+	}
+	if errs == nil {
+		panic("property.StaleWhileRevalidate: cannot be created from nil errs")
+	}
+	if property == nil {
+		panic("property.StaleWhileRevalidate: cannot be created from nil property")
+	}
+	c := &staleWhileRevalidate[T]{
+		soft:     soft,
+		hard:     hard,
+		clock:    clock,
+		errs:     errs,
+		requests: make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		property: property,
+	}
+	c.wg.Add(1)
+	go c.refresher()
+	return c
+}
+
+// staleWhileRevalidate implements Property[T any] interface
+type staleWhileRevalidate[T any] struct {
+	// underlying serializes messages sent to property
+	underlying sync.Mutex
+	// mu guards value and loaded
+	mu       sync.Mutex
+	soft     time.Duration
+	hard     time.Duration
+	clock    Clock
+	errs     func(error)
+	value    *T
+	loaded   time.Time
+	requests chan struct{}
+	done     chan struct{}
+	closing  sync.Once
+	wg       sync.WaitGroup
+	property Property[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *staleWhileRevalidate[T]) Change(value T) error {
+	c.underlying.Lock()
+	defer c.underlying.Unlock()
+	err := c.property.Change(value)
+	if err == nil {
+		c.store(value)
+	}
+	return err
+}
+
+// Value message returns in-memory cached value and nil while it is younger
+// than hard, otherwise it delegates to underlying property.
+//
+// Error of underlying property is returned.
+func (c *staleWhileRevalidate[T]) Value() (T, error) {
+	c.mu.Lock()
+	if c.value != nil {
+		age := c.clock.Now().Sub(c.loaded)
+		if age < c.hard {
+			value := *c.value
+			if age >= c.soft {
+				c.request()
+			}
+			c.mu.Unlock()
+			return value, nil
+		}
+	}
+	c.mu.Unlock()
+	c.underlying.Lock()
+	defer c.underlying.Unlock()
+	if value, ok := c.fresh(); ok {
+		return value, nil
+	}
+	value, err := c.property.Value()
+	if err == nil {
+		c.store(value)
+	}
+	return value, err
+}
+
+// Close message stops the refresher goroutine, it waits for a refresh
+// which is in flight. Close is idempotent and always returns nil.
+func (c *staleWhileRevalidate[T]) Close() error {
+	c.closing.Do(func() {
+		close(c.done)
+	})
+	c.wg.Wait()
+	return nil
+}
+
+// fresh returns cached value unless it is missing or older than hard,
+// a caller which waited for underlying uses it to skip a second load.
+func (c *staleWhileRevalidate[T]) fresh() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.value == nil || !c.clock.Now().Before(c.loaded.Add(c.hard)) {
+		var zero T
+		return zero, false
+	}
+	return *c.value, true
+}
+
+func (c *staleWhileRevalidate[T]) store(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value = &value
+	c.loaded = c.clock.Now()
+}
+
+// request asks refresher for a refresh without blocking, requests
+// made while one is pending are coalesced.
+func (c *staleWhileRevalidate[T]) request() {
+	select {
+	case c.requests <- struct{}{}:
+	default:
+	}
+}
+
+func (c *staleWhileRevalidate[T]) refresher() {
+	defer c.wg.Done()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.requests:
+			c.refresh()
+		}
+	}
+}
+
+func (c *staleWhileRevalidate[T]) refresh() {
+	c.underlying.Lock()
+	value, err := c.property.Value()
+	if err == nil {
+		c.store(value)
+	}
+	c.underlying.Unlock()
+	if err != nil {
+		c.errs(err)
+	}
+}
@@ -0,0 +1,123 @@
+package property
+
+import "sync"
+
+// Subscription is returned by Subscribe, Unsubscribe stops further
+// notifications and is idempotent. A notification which has already
+// started may still be delivered after Unsubscribe returns.
+type Subscription interface {
+	Unsubscribe()
+}
+
+// Subscribable returns implementation of Property[T any] interface.
+// it is the variant of Broadcast whose receivers subscribe and unsubscribe
+// at any time, it is safe for concurrent use.
+//
+// Zero subscribers are allowed. Subscribers are notified in subscription
+// order, outside of any lock, with a snapshot taken when the change
+// succeeded, so they may Subscribe or Unsubscribe while being notified.
+// A subscriber removed before its turn in the snapshot is skipped.
+// A subscriber that panics does not prevent the others from being notified,
+// the recovered value is passed to recovered.
+//
+// panic when:
+//   - recovered is nil.
+//   - property is nil.
+func Subscribable[T any](recovered func(any), property Property[T]) *subscribable[T] {
+	if recovered == nil {
+		panic("property.Subscribable: cannot be created from nil recovered")
+	}
+	if property == nil {
+		panic("property.Subscribable: cannot be created from nil property")
+	}
+	return &subscribable[T]{
+		recovered: recovered,
+		property:  property,
+	}
+}
+
+type subscribable[T any] struct {
+	mu sync.Mutex
+	// subscribers is replaced, never mutated, so snapshots stay valid
+	subscribers []*subscriber[T]
+	recovered   func(any)
+	property    Property[T]
+}
+
+type subscriber[T any] struct {
+	fx func(T)
+	// unsubscribed is guarded by mu of owner
+	unsubscribed bool
+	owner        *subscribable[T]
+}
+
+// Subscribe message registers fx to be notified of every later
+// successful change.
+//
+// # Panic when fx is nil
+func (s *subscribable[T]) Subscribe(fx func(T)) Subscription {
+	if fx == nil {
+		panic("property.Subscribable: cannot subscribe nil function")
+	}
+	sub := &subscriber[T]{fx: fx, owner: s}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subscribers := make([]*subscriber[T], len(s.subscribers), len(s.subscribers)+1)
+	copy(subscribers, s.subscribers)
+	s.subscribers = append(subscribers, sub)
+	return sub
+}
+
+func (sub *subscriber[T]) Unsubscribe() {
+	s := sub.owner
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub.unsubscribed = true
+	for i, other := range s.subscribers {
+		if other == sub {
+			subscribers := make([]*subscriber[T], 0, len(s.subscribers)-1)
+			subscribers = append(subscribers, s.subscribers[:i]...)
+			s.subscribers = append(subscribers, s.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, subscribers get notified with the same value.
+//
+// Error of underlying property is returned.
+func (s *subscribable[T]) Change(value T) error {
+	if err := s.property.Change(value); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	subscribers := s.subscribers
+	s.mu.Unlock()
+	for _, sub := range subscribers {
+		s.notify(sub, value)
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (s *subscribable[T]) Value() (T, error) {
+	return s.property.Value()
+}
+
+func (s *subscribable[T]) notify(sub *subscriber[T], value T) {
+	s.mu.Lock()
+	unsubscribed := sub.unsubscribed
+	s.mu.Unlock()
+	if unsubscribed {
+		return
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			s.recovered(v)
+		}
+	}()
+	sub.fx(value)
+}
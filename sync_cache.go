@@ -0,0 +1,97 @@
+package property
+
+import "sync"
+
+// SyncCache is the concurrency-safe variant of Cache, it can be shared
+// by multiple goroutines.
+//
+// messages:
+//   - Change updates underlying property and in memory value if no error occur,
+//     Change messages are serialized.
+//   - Value  returns in-memory cached value and nil, Value messages run in
+//     parallel with each other.
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh re-reads underlying property.
+//
+// # Panic when property argument is nil
+//
+// See SyncLazyCache if lazy loading is needed.
+func SyncCache[T any](value T, property Property[T]) *syncCache[T] {
+	if property == nil {
+		panic("property.SyncCache: cannot be created from nil property")
+	}
+	return &syncCache[T]{
+		value:    value,
+		property: property,
+	}
+}
+
+// syncCache implements Property[T any] interface
+type syncCache[T any] struct {
+	mu       sync.RWMutex
+	value    T
+	stale    bool
+	property Property[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *syncCache[T]) Change(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	err := c.property.Change(value)
+	if err == nil {
+		c.value = value
+		c.stale = false
+	}
+	return err
+}
+
+// Value message returns in-memory cached value and nil.
+// No delegation occurs to underlying property unless cache is invalidated.
+func (c *syncCache[T]) Value() (T, error) {
+	c.mu.RLock()
+	if !c.stale {
+		defer c.mu.RUnlock()
+		return c.value, nil
+	}
+	c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stale {
+		if err := c.refresh(); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	return c.value, nil
+}
+
+// Invalidate message empties the cache, next Value delegates
+// to underlying property.
+func (c *syncCache[T]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stale = true
+}
+
+// Refresh message delegates to underlying property, when no error occur
+// in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *syncCache[T]) Refresh() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refresh()
+}
+
+func (c *syncCache[T]) refresh() error {
+	value, err := c.property.Value()
+	if err == nil {
+		c.value = value
+		c.stale = false
+	}
+	return err
+}
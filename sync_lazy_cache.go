@@ -0,0 +1,149 @@
+package property
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SyncLazyCache is the concurrency-safe variant of LazyCache, it can be
+// shared by multiple goroutines.
+//
+// messages:
+//   - Change updates underlying property and in memory value if no error occur,
+//     Change messages are serialized.
+//   - Value  returns in-memory cached value, first Value loads it from
+//     underlying property. Once loaded, Value messages run in parallel.
+//   - Invalidate empties the cache, next Value delegates to underlying property.
+//   - Refresh re-reads underlying property.
+//
+// Loads are deduplicated, when many goroutines send Value before the first
+// load finishes, exactly one Value is sent to underlying property and its
+// result (value or error) is handed to every waiter. On error nothing is
+// cached, so the next Value starts a new load.
+//
+// # Panic when property argument is nil
+func SyncLazyCache[T any](property Property[T]) *syncLazyCache[T] {
+	if property == nil {
+		panic("property.SyncLazyCache: cannot be created from nil property")
+	}
+	return &syncLazyCache[T]{
+		property: property,
+	}
+}
+
+// syncLazyCache implements Property[T any] interface
+type syncLazyCache[T any] struct {
+	// underlying serializes messages sent to property
+	underlying sync.Mutex
+	// mu guards value and loading
+	mu       sync.RWMutex
+	value    *T
+	loading  *load[T]
+	property Property[T]
+}
+
+// load represents an in-flight Value sent to underlying property,
+// done is closed once value and err are set.
+type load[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *syncLazyCache[T]) Change(value T) error {
+	c.underlying.Lock()
+	defer c.underlying.Unlock()
+	err := c.property.Change(value)
+	if err == nil {
+		c.mu.Lock()
+		c.value = &value
+		c.mu.Unlock()
+	}
+	return err
+}
+
+// Value message returns in-memory cached value and nil when it is loaded,
+// otherwise it waits for the in-flight load or starts a new one.
+//
+// Error of underlying property is returned.
+func (c *syncLazyCache[T]) Value() (T, error) {
+	c.mu.RLock()
+	if c.value != nil {
+		value := *c.value
+		c.mu.RUnlock()
+		return value, nil
+	}
+	c.mu.RUnlock()
+	c.mu.Lock()
+	if c.value != nil {
+		value := *c.value
+		c.mu.Unlock()
+		return value, nil
+	}
+	l := c.loading
+	if l == nil {
+		l = &load[T]{done: make(chan struct{})}
+		c.loading = l
+		c.mu.Unlock()
+		c.load(l)
+	} else {
+		c.mu.Unlock()
+	}
+	<-l.done
+	return l.value, l.err
+}
+
+// Invalidate message empties the cache, a load which is in flight
+// is not cached either since it may have read the old value.
+func (c *syncLazyCache[T]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value = nil
+	c.loading = nil
+}
+
+// Refresh message delegates to underlying property, when no error occur
+// in-memory cached value will be updated.
+//
+// Error of underlying property is returned.
+func (c *syncLazyCache[T]) Refresh() error {
+	c.underlying.Lock()
+	defer c.underlying.Unlock()
+	value, err := c.property.Value()
+	if err == nil {
+		c.mu.Lock()
+		c.value = &value
+		c.mu.Unlock()
+	}
+	return err
+}
+
+// load sends Value to underlying property and publishes the result to l,
+// it caches the value unless a Change has happened in the meanwhile.
+// When underlying property panics, waiters get an error and the panic
+// continues in the loading goroutine.
+func (c *syncLazyCache[T]) load(l *load[T]) {
+	defer close(l.done)
+	c.underlying.Lock()
+	defer c.underlying.Unlock()
+	var loaded bool
+	defer func() {
+		if !loaded {
+			l.err = fmt.Errorf("property.SyncLazyCache: underlying property panicked")
+		}
+		c.mu.Lock()
+		if c.loading == l {
+			c.loading = nil
+			if l.err == nil && c.value == nil {
+				c.value = &l.value
+			}
+		}
+		c.mu.Unlock()
+	}()
+	l.value, l.err = c.property.Value()
+	loaded = true
+}
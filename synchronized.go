@@ -0,0 +1,51 @@
+package property
+
+import "sync"
+
+// Synchronized returns implementation of Property[T any] interface
+// which is safe for concurrent use by multiple goroutines.
+//
+// messages:
+//   - Change is serialized, only one Change runs at a time and no Value
+//     runs in parallel with it.
+//   - Value  runs in parallel with other Value messages.
+//
+// Since Value messages run in parallel, underlying property must not mutate
+// itself on Value. LazyCache, Cache after Invalidate and x.Simple do, use
+// SyncLazyCache, SyncCache and x.SyncSimple instead of wrapping them.
+//
+// # Panic when property argument is nil
+func Synchronized[T any](property Property[T]) *synchronized[T] {
+	if property == nil {
+		panic("property.Synchronized: cannot be created from nil property")
+	}
+	return &synchronized[T]{
+		property: property,
+	}
+}
+
+// synchronized implements Property[T any] interface
+type synchronized[T any] struct {
+	mu       sync.RWMutex
+	property Property[T]
+}
+
+// Change message delegates to underlying property while holding
+// the write lock.
+//
+// Error of underlying property is returned.
+func (s *synchronized[T]) Change(value T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.property.Change(value)
+}
+
+// Value message delegates to underlying property while holding
+// the read lock.
+//
+// Error of underlying property is returned.
+func (s *synchronized[T]) Value() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.property.Value()
+}
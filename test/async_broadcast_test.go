@@ -0,0 +1,92 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_AsyncBroadcast_panic_when_receivers_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.AsyncBroadcast: cannot be created with zero receivers"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.AsyncBroadcast[int](1, 1, property.OverflowBlock, nil, delegate[int]{t: t})
+}
+
+func Test_asyncBroadcast_receivers_get_values_in_change_order(t *testing.T) {
+	var mu sync.Mutex
+	got := map[int][]int{}
+	receiver := func(id int) func(int) {
+		return func(value int) {
+			mu.Lock()
+			defer mu.Unlock()
+			got[id] = append(got[id], value)
+		}
+	}
+	receivers := []func(int){receiver(0), receiver(1), receiver(2)}
+	broadcast := property.AsyncBroadcast[int](2, 4, property.OverflowBlock, receivers, &memory[int]{})
+	var expected []int
+	for i := 0; i < 100; i++ {
+		broadcast.Change(i)
+		expected = append(expected, i)
+	}
+	broadcast.Close()
+	for id := range receivers {
+		if fmt.Sprint(got[id]) != fmt.Sprint(expected) {
+			t.Errorf("receiver %v: expected values in order got (%v)", id, got[id])
+		}
+	}
+}
+
+func Test_asyncBroadcast_overflow_drops_values(t *testing.T) {
+	table := []struct {
+		overflow property.Overflow
+		expected string
+	}{
+		{property.OverflowDropOldest, "[1 3 4]"},
+		{property.OverflowDropNewest, "[1 2 3]"},
+	}
+	for _, data := range table {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var got []int
+		receivers := []func(int){
+			func(value int) {
+				if value == 1 {
+					close(started)
+					<-release
+				}
+				got = append(got, value)
+			},
+		}
+		broadcast := property.AsyncBroadcast[int](1, 2, data.overflow, receivers, &memory[int]{})
+		broadcast.Change(1)
+		<-started
+		broadcast.Change(2)
+		broadcast.Change(3)
+		broadcast.Change(4)
+		close(release)
+		broadcast.Close()
+		if fmt.Sprint(got) != data.expected {
+			t.Errorf("overflow %v: expected (%v) got (%v)", data.overflow, data.expected, got)
+		}
+	}
+}
+
+func Test_asyncBroadcast_Change_after_Close_returns_ErrClosed(t *testing.T) {
+	receivers := []func(int){func(int) {}}
+	broadcast := property.AsyncBroadcast[int](1, 1, property.OverflowBlock, receivers, forbidden[int]{t})
+	broadcast.Close()
+	if err := broadcast.Change(1); err != property.ErrClosed {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrClosed, err)
+	}
+	if err := broadcast.Close(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_audited_ChangeAs_records_success(t *testing.T) {
+	clock := newClock()
+	sink := property.MemorySink()
+	audited := property.Audited[string]("email", clock, sink, &memory[string]{value: "old@go.dev"})
+	audited.ChangeAs("admin", "new@go.dev")
+	records := sink.Records()
+	if len(records) != 1 {
+		t.Fatalf("expected one record got (%v)", len(records))
+	}
+	expected := property.AuditRecord{
+		Property: "email",
+		Actor:    "admin",
+		Time:     clock.Now(),
+		Old:      "old@go.dev",
+		New:      "new@go.dev",
+		Success:  true,
+	}
+	if records[0] != expected {
+		t.Errorf("expected record (%+v) got (%+v)", expected, records[0])
+	}
+}
+
+func Test_audited_Change_records_guard_rejection(t *testing.T) {
+	expected := fmt.Errorf("rejected")
+	sink := property.MemorySink()
+	rule := rule[int]{evaluate: func(int) error { return expected }}
+	audited := property.Audited[int]("age", newClock(), sink, property.Guard[int](rule, &memory[int]{}))
+	if err := audited.Change(-1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	records := sink.Records()
+	if len(records) != 1 || records[0].Success || records[0].Error != "rejected" {
+		t.Errorf("expected failed record with error (rejected) got (%+v)", records)
+	}
+}
+
+func Test_contextAudited_reads_actor_from_context(t *testing.T) {
+	sink := property.MemorySink()
+	audited := property.ContextAudited[int]("age", newClock(), sink, property.WithContext[int](&memory[int]{}))
+	audited.Change(property.WithActor(context.Background(), "alice"), 1)
+	records := sink.Records()
+	if len(records) != 1 || records[0].Actor != "alice" {
+		t.Errorf("expected record of actor (alice) got (%+v)", records)
+	}
+}
+
+func Test_func_OpenJSONLinesSink_appends_one_line_per_record(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := property.OpenJSONLinesSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audited := property.Audited[int]("age", newClock(), sink, &memory[int]{})
+	audited.ChangeAs("alice", 1)
+	audited.ChangeAs("bob", 2)
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var actors []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record property.AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line is not valid JSON (%v)", err)
+		}
+		actors = append(actors, record.Actor)
+	}
+	if fmt.Sprint(actors) != "[alice bob]" {
+		t.Errorf("expected actors [alice bob] got (%v)", actors)
+	}
+}
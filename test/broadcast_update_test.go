@@ -0,0 +1,77 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_BroadcastUpdate_panic_when_receivers_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.BroadcastUpdate: cannot be created with zero receivers"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.BroadcastUpdate[string](nil, delegate[string]{t: t})
+}
+
+func Test_broadcastUpdate_Change_notifies_receivers_with_old_and_new_value(t *testing.T) {
+	var got []string
+	receivers := []func(old, new string){
+		func(old, new string) { got = append(got, old+"->"+new) },
+		func(old, new string) { got = append(got, old+"->"+new) },
+	}
+	broadcast := property.BroadcastUpdate[string](receivers, &memory[string]{value: "go"})
+	broadcast.Change("golang")
+	if fmt.Sprint(got) != "[go->golang go->golang]" {
+		t.Errorf("expected receivers to get (go->golang) got (%v)", got)
+	}
+}
+
+func Test_broadcastUpdate_Change_is_not_applied_when_old_value_cannot_be_read(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:     t,
+		value: func() (int, error) { return 0, expected },
+	}
+	receivers := []func(old, new int){
+		func(int, int) { t.Error("receiver got notified") },
+	}
+	broadcast := property.BroadcastUpdate[int](receivers, delegation)
+	if err := broadcast.Change(1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+}
+
+func Test_broadcastUpdate_Change_notifies_zero_old_value_when_not_found(t *testing.T) {
+	var got []string
+	receivers := []func(old, new string){
+		func(old, new string) { got = append(got, old+"->"+new) },
+	}
+	broadcast := property.BroadcastUpdate[string](receivers, &unwritten[string]{})
+	if err := broadcast.Change("go"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if fmt.Sprint(got) != "[->go]" {
+		t.Errorf("expected receivers to get (->go) got (%v)", got)
+	}
+}
+
+func Test_broadcastUpdate_Change_does_not_notify_on_error(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:      t,
+		value:  func() (int, error) { return 0, nil },
+		change: func(int) error { return expected },
+	}
+	receivers := []func(old, new int){
+		func(int, int) { t.Error("receiver got notified") },
+	}
+	broadcast := property.BroadcastUpdate[int](receivers, delegation)
+	if err := broadcast.Change(1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+}
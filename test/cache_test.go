@@ -147,3 +147,40 @@ func Test_cache_Change_when_underlying_property_returns_error_cache_does_not_get
 		}
 	}
 }
+
+func Test_cache_Invalidate_makes_Value_delegate_to_underlying_property(t *testing.T) {
+	delegation := delegate[string]{
+		t:     t,
+		value: func() (string, error) { return "new", nil },
+	}
+	cache := property.Cache[string]("old", delegation)
+	cache.Invalidate()
+	got, err := cache.Value()
+	if got != "new" || err != nil {
+		t.Errorf("Expected (new, nil) got (%v, %v)", got, err)
+	}
+}
+
+func Test_cache_Refresh_updates_cache_only_when_underlying_property_succeed(t *testing.T) {
+	table := []struct {
+		err      error
+		expected string
+	}{
+		{nil, "new"},
+		{fmt.Errorf("any error"), "old"},
+	}
+	for _, data := range table {
+		delegation := delegate[string]{
+			t:     t,
+			value: func() (string, error) { return "new", data.err },
+		}
+		cache := property.Cache[string]("old", delegation)
+		if err := cache.Refresh(); err != data.err {
+			t.Errorf("Expected error is (%v) got (%v)", data.err, err)
+		}
+		got, _ := cache.Value()
+		if got != data.expected {
+			t.Errorf("Expected value is (%v) got (%v)", data.expected, got)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_CircuitBreaker_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.CircuitBreaker: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.CircuitBreaker[int](property.ConsecutiveFailures(1), 0, time.Second, newClock(), func(from, to property.CircuitState) {}, nil)
+}
+
+func Test_circuitBreaker_opens_after_consecutive_failures_and_fails_fast(t *testing.T) {
+	var invoked int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			invoked++
+			return 0, fmt.Errorf("any error")
+		},
+	}
+	var changes []string
+	onChange := func(from, to property.CircuitState) {
+		changes = append(changes, from.String()+"->"+to.String())
+	}
+	breaker := property.CircuitBreaker[int](property.ConsecutiveFailures(2), 0, time.Minute, newClock(), onChange, delegation)
+	breaker.Value()
+	breaker.Value()
+	if _, err := breaker.Value(); err != property.ErrCircuitOpen {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrCircuitOpen, err)
+	}
+	if invoked != 2 {
+		t.Errorf("expected (2) delegations got (%v)", invoked)
+	}
+	if got := breaker.State(); got != property.CircuitOpen {
+		t.Errorf("expected state is (%v) got (%v)", property.CircuitOpen, got)
+	}
+	expected := []string{"closed->open"}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes (%v) got (%v)", expected, changes)
+	}
+}
+
+func Test_circuitBreaker_half_open_trial_closes_or_reopens_circuit(t *testing.T) {
+	table := []struct {
+		trial    error
+		expected []string
+		state    property.CircuitState
+	}{
+		{nil, []string{"closed->open", "open->half-open", "half-open->closed"}, property.CircuitClosed},
+		{fmt.Errorf("any error"), []string{"closed->open", "open->half-open", "half-open->open"}, property.CircuitOpen},
+	}
+	for _, data := range table {
+		clock := newClock()
+		fail := fmt.Errorf("any error")
+		delegation := delegate[int]{
+			t:      t,
+			change: func(int) error { return fail },
+		}
+		var changes []string
+		onChange := func(from, to property.CircuitState) {
+			changes = append(changes, from.String()+"->"+to.String())
+		}
+		breaker := property.CircuitBreaker[int](property.ConsecutiveFailures(1), 0, time.Minute, clock, onChange, delegation)
+		breaker.Change(1)
+		clock.Advance(time.Minute)
+		fail = data.trial
+		if err := breaker.Change(1); err != data.trial {
+			t.Errorf("expected error is (%v) got (%v)", data.trial, err)
+		}
+		if !reflect.DeepEqual(changes, data.expected) {
+			t.Errorf("expected changes (%v) got (%v)", data.expected, changes)
+		}
+		if got := breaker.State(); got != data.state {
+			t.Errorf("expected state is (%v) got (%v)", data.state, got)
+		}
+	}
+}
+
+func Test_circuitBreaker_opens_on_failure_ratio(t *testing.T) {
+	outcomes := []error{nil, fmt.Errorf("any error"), nil, fmt.Errorf("any error")}
+	var i int
+	delegation := delegate[int]{
+		t: t,
+		change: func(int) error {
+			err := outcomes[i]
+			i++
+			return err
+		},
+	}
+	breaker := property.CircuitBreaker[int](property.FailureRatio(0.5, 4), 0, time.Minute, newClock(), func(from, to property.CircuitState) {}, delegation)
+	for range outcomes[:3] {
+		breaker.Change(1)
+		if got := breaker.State(); got != property.CircuitClosed {
+			t.Fatalf("expected state is (%v) got (%v)", property.CircuitClosed, got)
+		}
+	}
+	breaker.Change(1)
+	if got := breaker.State(); got != property.CircuitOpen {
+		t.Errorf("expected state is (%v) got (%v)", property.CircuitOpen, got)
+	}
+}
+
+func Test_circuitBreaker_ignores_outcome_of_call_started_before_trial(t *testing.T) {
+	clock := newClock()
+	slowStarted, slowRelease := make(chan struct{}), make(chan struct{})
+	trialStarted, trialRelease := make(chan struct{}), make(chan struct{})
+	delegation := delegate[string]{
+		t: t,
+		change: func(value string) error {
+			switch value {
+			case "slow":
+				close(slowStarted)
+				<-slowRelease
+				return nil
+			case "trial":
+				close(trialStarted)
+				<-trialRelease
+			}
+			return fmt.Errorf("any error")
+		},
+	}
+	var mu sync.Mutex
+	var changes []string
+	onChange := func(from, to property.CircuitState) {
+		mu.Lock()
+		defer mu.Unlock()
+		changes = append(changes, from.String()+"->"+to.String())
+	}
+	breaker := property.CircuitBreaker[string](property.ConsecutiveFailures(1), 0, time.Minute, clock, onChange, delegation)
+	slowDone := make(chan error)
+	go func() { slowDone <- breaker.Change("slow") }()
+	<-slowStarted
+	breaker.Change("fail")
+	clock.Advance(time.Minute)
+	trialDone := make(chan error)
+	go func() { trialDone <- breaker.Change("trial") }()
+	<-trialStarted
+	close(slowRelease)
+	if err := <-slowDone; err != nil {
+		t.Errorf("expected slow call error is (nil) got (%v)", err)
+	}
+	if got := breaker.State(); got != property.CircuitHalfOpen {
+		t.Errorf("expected state is (%v) got (%v)", property.CircuitHalfOpen, got)
+	}
+	if err := breaker.Change("other"); err != property.ErrCircuitOpen {
+		t.Errorf("expected error during trial is (%v) got (%v)", property.ErrCircuitOpen, err)
+	}
+	close(trialRelease)
+	<-trialDone
+	expected := []string{"closed->open", "open->half-open", "half-open->open"}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes (%v) got (%v)", expected, changes)
+	}
+}
+
+func Test_circuitBreaker_panicking_trial_counts_as_failure(t *testing.T) {
+	clock := newClock()
+	var panics bool
+	delegation := delegate[int]{
+		t: t,
+		change: func(int) error {
+			if panics {
+				panic("boom")
+			}
+			return fmt.Errorf("any error")
+		},
+	}
+	breaker := property.CircuitBreaker[int](property.ConsecutiveFailures(1), 0, time.Minute, clock, func(from, to property.CircuitState) {}, delegation)
+	breaker.Change(1)
+	clock.Advance(time.Minute)
+	panics = true
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic of underlying property to propagate")
+			}
+		}()
+		breaker.Change(1)
+	}()
+	if got := breaker.State(); got != property.CircuitOpen {
+		t.Errorf("expected state is (%v) got (%v)", property.CircuitOpen, got)
+	}
+	clock.Advance(time.Minute)
+	panics = false
+	if err := breaker.Change(1); err == property.ErrCircuitOpen {
+		t.Error("expected a new trial after cooldown got (ErrCircuitOpen)")
+	}
+}
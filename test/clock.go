@@ -0,0 +1,79 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+// clock is a fake property.Scheduler whose time moves only by Advance,
+// functions scheduled by AfterFunc run within Advance.
+type clock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*timer
+}
+
+type timer struct {
+	at      time.Time
+	f       func()
+	stopped bool
+}
+
+func newClock() *clock {
+	return &clock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) AfterFunc(d time.Duration, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &timer{at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		stopped := !t.stopped
+		t.stopped = true
+		return stopped
+	}
+}
+
+// Advance moves time forward by d and runs due functions in time order.
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	for {
+		var next *timer
+		for _, t := range c.timers {
+			if !t.stopped && !t.at.After(end) && (next == nil || t.at.Before(next.at)) {
+				next = t
+			}
+		}
+		if next == nil {
+			break
+		}
+		next.stopped = true
+		if next.at.After(c.now) {
+			c.now = next.at
+		}
+		c.mu.Unlock()
+		next.f()
+		c.mu.Lock()
+	}
+	c.now = end
+	c.mu.Unlock()
+}
+
+// sleeper is a fake property.Sleeper which records requested durations.
+type sleeper struct {
+	slept []time.Duration
+}
+
+func (s *sleeper) Sleep(d time.Duration) {
+	s.slept = append(s.slept, d)
+}
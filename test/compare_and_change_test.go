@@ -0,0 +1,87 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_compareAndChange_changes_only_when_expected_matches(t *testing.T) {
+	table := []struct {
+		expected string
+		err      error
+		value    string
+	}{
+		{"old", nil, "new"},
+		{"other", property.ErrConflict, "old"},
+	}
+	for _, data := range table {
+		memory := &memory[string]{value: "old"}
+		cas := property.CompareAndChange[string](memory)
+		if err := cas.CompareAndChange(data.expected, "new"); err != data.err {
+			t.Errorf("expected error is (%v) got (%v)", data.err, err)
+		}
+		if memory.value != data.value {
+			t.Errorf("expected value is (%v) got (%v)", data.value, memory.value)
+		}
+	}
+}
+
+func Test_compareAndChange_treats_not_found_as_zero(t *testing.T) {
+	table := []struct {
+		expected string
+		err      error
+	}{
+		{"old", property.ErrConflict},
+		{"", nil},
+	}
+	for _, data := range table {
+		unwritten := &unwritten[string]{}
+		cas := property.CompareAndChange[string](unwritten)
+		if err := cas.CompareAndChange(data.expected, "new"); err != data.err {
+			t.Errorf("expected error is (%v) got (%v)", data.err, err)
+		}
+	}
+}
+
+func Test_compareAndChange_concurrent_writers_conflict(t *testing.T) {
+	const writers = 16
+	cas := property.CompareAndChange[int](&memory[int]{})
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var succeeded int
+	for i := 1; i <= writers; i++ {
+		wg.Add(1)
+		go func(value int) {
+			defer wg.Done()
+			if cas.CompareAndChange(0, value) == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if succeeded != 1 {
+		t.Errorf("expected exactly one writer to succeed got (%v)", succeeded)
+	}
+}
+
+func Test_versioned_CompareAndChange_detects_writer_in_between(t *testing.T) {
+	datasource := &versionedMemory{memory: memory[string]{value: "old"}}
+	datasource.interleave = func() {
+		datasource.interleave = nil
+		datasource.Change("other")
+	}
+	versioned := property.Versioned[string, int](datasource)
+	if err := versioned.CompareAndChange("old", "new"); err != property.ErrConflict {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrConflict, err)
+	}
+	if err := versioned.CompareAndChange("other", "new"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if datasource.value != "new" {
+		t.Errorf("expected value is (new) got (%v)", datasource.value)
+	}
+}
@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+type ctxKey struct{}
+
+// contextDelegate is a ContextProperty which records the value of ctxKey.
+type contextDelegate[T any] struct {
+	seen  *[]any
+	value T
+	err   error
+}
+
+func (c contextDelegate[T]) Change(ctx context.Context, value T) error {
+	*c.seen = append(*c.seen, ctx.Value(ctxKey{}))
+	return c.err
+}
+
+func (c contextDelegate[T]) Value(ctx context.Context) (T, error) {
+	*c.seen = append(*c.seen, ctx.Value(ctxKey{}))
+	return c.value, c.err
+}
+
+func Test_withContext_does_not_delegate_when_context_is_done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	adapter := property.WithContext[int](forbidden[int]{t})
+	if err := adapter.Change(ctx, 1); err != context.Canceled {
+		t.Errorf("expected error is (%v) got (%v)", context.Canceled, err)
+	}
+	if _, err := adapter.Value(ctx); err != context.Canceled {
+		t.Errorf("expected error is (%v) got (%v)", context.Canceled, err)
+	}
+}
+
+func Test_withoutContext_delegates_with_bound_context(t *testing.T) {
+	var seen []any
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	adapter := property.WithoutContext[int](ctx, contextDelegate[int]{seen: &seen})
+	adapter.Change(1)
+	adapter.Value()
+	if len(seen) != 2 || seen[0] != "request" || seen[1] != "request" {
+		t.Errorf("expected context to reach underlying property got (%v)", seen)
+	}
+}
+
+func Test_contextGuard_Change_rule_violation_prevents_delegation(t *testing.T) {
+	var seen []any
+	expected := fmt.Errorf("any error")
+	rule := rule[int]{evaluate: func(int) error { return expected }}
+	guard := property.ContextGuard[int](rule, contextDelegate[int]{seen: &seen})
+	if err := guard.Change(context.Background(), 1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	if len(seen) != 0 {
+		t.Errorf("delegation did occur to underlying property")
+	}
+}
+
+func Test_contextCache_Value_returns_what_is_in_cache_without_delegation(t *testing.T) {
+	var seen []any
+	cache := property.ContextCache[string]("go", contextDelegate[string]{seen: &seen, value: "other"})
+	got, err := cache.Value(context.Background())
+	if got != "go" || err != nil {
+		t.Errorf("expected (go, nil) got (%v, %v)", got, err)
+	}
+	if len(seen) != 0 {
+		t.Errorf("delegation did occur to underlying property")
+	}
+}
+
+func Test_contextCache_Change_updates_cache_only_when_underlying_property_succeeds(t *testing.T) {
+	table := []struct {
+		err      error
+		expected string
+	}{
+		{nil, "golang"},
+		{fmt.Errorf("any error"), "go"},
+	}
+	for _, data := range table {
+		var seen []any
+		ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+		cache := property.ContextCache[string]("go", contextDelegate[string]{seen: &seen, err: data.err})
+		if err := cache.Change(ctx, "golang"); err != data.err {
+			t.Errorf("expected error is (%v) got (%v)", data.err, err)
+		}
+		if len(seen) != 1 || seen[0] != "request" {
+			t.Errorf("expected context to reach underlying property got (%v)", seen)
+		}
+		if got, _ := cache.Value(ctx); got != data.expected {
+			t.Errorf("expected value is (%v) got (%v)", data.expected, got)
+		}
+	}
+}
+
+func Test_contextLazyCache_does_not_cache_failed_load(t *testing.T) {
+	var seen []any
+	delegation := contextDelegate[int]{seen: &seen, err: context.DeadlineExceeded}
+	cache := property.ContextLazyCache[int](delegation)
+	cache.Value(context.Background())
+	cache.Value(context.Background())
+	if len(seen) != 2 {
+		t.Errorf("expected (2) delegations got (%v)", len(seen))
+	}
+}
+
+func Test_contextBroadcast_passes_context_to_receivers(t *testing.T) {
+	var seen []any
+	var got any
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	receivers := []func(context.Context, string){
+		func(ctx context.Context, _ string) { got = ctx.Value(ctxKey{}) },
+	}
+	broadcast := property.ContextBroadcast[string](receivers, contextDelegate[string]{seen: &seen})
+	broadcast.Change(ctx, "Go")
+	if got != "request" {
+		t.Errorf("expected receiver to get context value (request) got (%v)", got)
+	}
+}
+
+func Test_contextInequality_Change_skips_equal_value(t *testing.T) {
+	var seen []any
+	inequality := property.ContextInequality[string](contextDelegate[string]{seen: &seen, value: "Go"})
+	inequality.Change(context.Background(), "Go")
+	if len(seen) != 1 {
+		t.Errorf("expected only Value to be delegated got (%v) delegations", len(seen))
+	}
+}
+
+func Test_contextInequality_Change_delegates_when_value_is_not_found(t *testing.T) {
+	unwritten := &unwritten[string]{}
+	inequality := property.ContextInequality[string](property.WithContext[string](unwritten))
+	if err := inequality.Change(context.Background(), "Go"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if got, err := unwritten.Value(); got != "Go" || err != nil {
+		t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+	}
+}
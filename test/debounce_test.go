@@ -0,0 +1,126 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Debounce_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Debounce: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Debounce[int](time.Second, newClock(), func(error) {}, nil)
+}
+
+func Test_debounce_sends_only_last_value_after_quiet_period(t *testing.T) {
+	clock := newClock()
+	var written []int
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			written = append(written, value)
+			return nil
+		},
+	}
+	debounce := property.Debounce[int](time.Second, clock, func(error) {}, delegation)
+	for i := 1; i <= 3; i++ {
+		debounce.Change(i)
+		clock.Advance(500 * time.Millisecond)
+	}
+	if len(written) != 0 {
+		t.Errorf("expected no write before quiet period got (%v)", written)
+	}
+	if got, _ := debounce.Value(); got != 3 {
+		t.Errorf("expected pending value (3) got (%v)", got)
+	}
+	clock.Advance(500 * time.Millisecond)
+	if fmt.Sprint(written) != "[3]" {
+		t.Errorf("expected [3] got (%v)", written)
+	}
+}
+
+func Test_debounce_reports_delayed_errors_and_Flush_returns_error(t *testing.T) {
+	clock := newClock()
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:      t,
+		change: func(int) error { return expected },
+	}
+	var reported []error
+	debounce := property.Debounce[int](time.Second, clock, func(err error) { reported = append(reported, err) }, delegation)
+	debounce.Change(1)
+	clock.Advance(time.Second)
+	if len(reported) != 1 || reported[0] != expected {
+		t.Errorf("expected reported [%v] got (%v)", expected, reported)
+	}
+	debounce.Change(2)
+	if err := debounce.Flush(); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	clock.Advance(time.Second)
+	if len(reported) != 2 {
+		t.Errorf("expected failed flushed value to be retried got (%v)", reported)
+	}
+}
+
+func Test_debounce_keeps_and_retries_value_whose_write_failed(t *testing.T) {
+	clock := newClock()
+	failing := true
+	memory := &memory[int]{}
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			if failing {
+				return fmt.Errorf("any error")
+			}
+			return memory.Change(value)
+		},
+		value: memory.Value,
+	}
+	debounce := property.Debounce[int](time.Second, clock, func(error) {}, delegation)
+	debounce.Change(1)
+	clock.Advance(time.Second)
+	if got, err := debounce.Value(); got != 1 || err != nil {
+		t.Errorf("expected pending (1, nil) got (%v, %v)", got, err)
+	}
+	failing = false
+	clock.Advance(time.Second)
+	if memory.value != 1 {
+		t.Errorf("expected retried value (1) got (%v)", memory.value)
+	}
+}
+
+func Test_debounce_errs_may_send_messages_to_decorator(t *testing.T) {
+	clock := newClock()
+	delegation := delegate[int]{
+		t:      t,
+		change: func(int) error { return fmt.Errorf("any error") },
+	}
+	var debounce property.Property[int]
+	var got int
+	d := property.Debounce[int](time.Second, clock, func(error) {
+		got, _ = debounce.Value()
+	}, delegation)
+	debounce = d
+	d.Change(1)
+	done := make(chan struct{})
+	go func() {
+		clock.Advance(time.Second)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected errs not to deadlock")
+	}
+	if got != 1 {
+		t.Errorf("expected pending value (1) got (%v)", got)
+	}
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Expiring_panic_when_arguments_are_invalid(t *testing.T) {
+	anyProperty := delegate[int]{t: t}
+	table := []struct {
+		ttl      time.Duration
+		clock    property.Clock
+		property property.Property[int]
+		expected string
+	}{
+		{0, newClock(), anyProperty, "property.Expiring: cannot be created with non-positive ttl"},
+		{time.Second, nil, anyProperty, "property.Expiring: cannot be created from nil clock"},
+		{time.Second, newClock(), nil, "property.Expiring: cannot be created from nil property"},
+	}
+	for _, data := range table {
+		func() {
+			defer func() {
+				got := recover()
+				if got != data.expected {
+					t.Errorf("expected message is (%v) got (%v)", data.expected, got)
+				}
+			}()
+			property.Expiring[int](data.ttl, data.clock, data.property)
+		}()
+	}
+}
+
+func Test_expiring_Value_delegates_only_after_ttl(t *testing.T) {
+	clock := newClock()
+	var invoked int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			invoked++
+			return invoked, nil
+		},
+	}
+	expiring := property.Expiring[int](time.Minute, clock, delegation)
+	table := []struct {
+		advance  time.Duration
+		expected int
+	}{
+		{0, 1},
+		{59 * time.Second, 1},
+		{time.Second, 2},
+		{30 * time.Second, 2},
+	}
+	for _, data := range table {
+		clock.Advance(data.advance)
+		got, _ := expiring.Value()
+		if got != data.expected {
+			t.Errorf("expected value is (%v) got (%v)", data.expected, got)
+		}
+	}
+}
+
+func Test_expiring_Change_resets_ttl(t *testing.T) {
+	clock := newClock()
+	delegation := delegate[string]{
+		t:      t,
+		change: func(string) error { return nil },
+	}
+	expiring := property.Expiring[string](time.Minute, clock, delegation)
+	clock.Advance(time.Hour)
+	expiring.Change("Go")
+	clock.Advance(59 * time.Second)
+	got, _ := expiring.Value()
+	if got != "Go" {
+		t.Errorf("expected value is (Go) got (%v)", got)
+	}
+}
+
+func Test_expiring_Value_error_is_not_cached(t *testing.T) {
+	clock := newClock()
+	var invoked int
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			invoked++
+			return 0, expected
+		},
+	}
+	expiring := property.Expiring[int](time.Minute, clock, delegation)
+	for i := 0; i < 2; i++ {
+		if _, err := expiring.Value(); err != expected {
+			t.Errorf("expected error is (%v) got (%v)", expected, err)
+		}
+	}
+	if invoked != 2 {
+		t.Errorf("expected two delegations to underlying property got (%v)", invoked)
+	}
+}
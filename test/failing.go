@@ -0,0 +1,14 @@
+package test
+
+// failing is a property which fails Change of given values.
+type failing[T comparable] struct {
+	memory[T]
+	fail map[T]error
+}
+
+func (f *failing[T]) Change(value T) error {
+	if err, ok := f.fail[value]; ok {
+		return err
+	}
+	return f.memory.Change(value)
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_FallibleBroadcast_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.FallibleBroadcast: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	receivers := []func(int) error{func(int) error { return nil }}
+	property.FallibleBroadcast[int](receivers, nil)
+}
+
+func Test_fallibleBroadcast_Change_notifies_all_receivers(t *testing.T) {
+	var got []string
+	receiver := func(name string) func(string) error {
+		return func(value string) error {
+			got = append(got, name+":"+value)
+			return nil
+		}
+	}
+	receivers := []func(string) error{receiver("a"), receiver("b")}
+	broadcast := property.FallibleBroadcast[string](receivers, &memory[string]{})
+	if err := broadcast.Change("go"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if fmt.Sprint(got) != "[a:go b:go]" {
+		t.Errorf("expected [a:go b:go] got (%v)", got)
+	}
+}
+
+func Test_fallibleBroadcast_Change_compensates_and_reverts_on_receiver_failure(t *testing.T) {
+	expected := fmt.Errorf("cascade failed")
+	var got []string
+	receiver := func(name string, err error) func(string) error {
+		return func(value string) error {
+			got = append(got, name+":"+value)
+			if value == "new" {
+				return err
+			}
+			return nil
+		}
+	}
+	receivers := []func(string) error{
+		receiver("a", nil),
+		receiver("b", nil),
+		receiver("c", expected),
+		receiver("d", nil),
+	}
+	memory := &memory[string]{value: "old"}
+	broadcast := property.FallibleBroadcast[string](receivers, memory)
+	err := broadcast.Change("new")
+	var receiverErr *property.ReceiverError
+	if !errors.As(err, &receiverErr) || receiverErr.Receiver != 2 || receiverErr.Compensation != nil {
+		t.Fatalf("expected ReceiverError of receiver 2 without compensation errors got (%v)", err)
+	}
+	if !errors.Is(err, expected) {
+		t.Errorf("expected error to wrap (%v) got (%v)", expected, err)
+	}
+	if fmt.Sprint(got) != "[a:new b:new c:new b:old a:old]" {
+		t.Errorf("expected compensation in reverse order got (%v)", got)
+	}
+	if memory.value != "old" {
+		t.Errorf("expected underlying property to be reverted to (old) got (%v)", memory.value)
+	}
+}
+
+func Test_fallibleBroadcast_Change_compensates_with_zero_when_not_found(t *testing.T) {
+	expected := fmt.Errorf("cascade failed")
+	var got []string
+	receivers := []func(string) error{
+		func(value string) error { got = append(got, "a:"+value); return nil },
+		func(value string) error { return expected },
+	}
+	unwritten := &unwritten[string]{}
+	broadcast := property.FallibleBroadcast[string](receivers, unwritten)
+	if err := broadcast.Change("new"); !errors.Is(err, expected) {
+		t.Errorf("expected error to wrap (%v) got (%v)", expected, err)
+	}
+	if fmt.Sprint(got) != "[a:new a:]" {
+		t.Errorf("expected compensation with zero value got (%v)", got)
+	}
+	if value, err := unwritten.Value(); value != "" || err != nil {
+		t.Errorf("expected underlying property to be reverted to zero got (%v, %v)", value, err)
+	}
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_History_panic_when_n_is_less_than_two(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.History: cannot be created with less than two values"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.History[int](1, &memory[int]{})
+}
+
+func Test_history_keeps_last_n_values(t *testing.T) {
+	history := property.History[int](3, &memory[int]{})
+	for _, value := range []int{1, 2, 3, 4} {
+		history.Change(value)
+	}
+	expected := []int{2, 3, 4}
+	if got := history.History(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected history (%v) got (%v)", expected, got)
+	}
+}
+
+func Test_history_Undo_and_Redo_restore_values(t *testing.T) {
+	memory := &memory[string]{value: "a"}
+	history := property.History[string](5, memory)
+	history.Change("b")
+	history.Change("c")
+	history.Undo()
+	history.Undo()
+	if memory.value != "a" {
+		t.Errorf("expected value after undo is (a) got (%v)", memory.value)
+	}
+	if err := history.Undo(); err != property.ErrNoHistory {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrNoHistory, err)
+	}
+	history.Redo()
+	if memory.value != "b" {
+		t.Errorf("expected value after redo is (b) got (%v)", memory.value)
+	}
+}
+
+func Test_history_Change_after_Undo_discards_redo(t *testing.T) {
+	history := property.History[int](5, &memory[int]{})
+	history.Change(1)
+	history.Undo()
+	history.Change(2)
+	if err := history.Redo(); err != property.ErrNoHistory {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrNoHistory, err)
+	}
+}
+
+func Test_history_Undo_goes_through_underlying_chain(t *testing.T) {
+	expected := fmt.Errorf("rejected")
+	memory := &memory[int]{}
+	rule := rule[int]{evaluate: func(value int) error {
+		if value == 0 {
+			return expected
+		}
+		return nil
+	}}
+	history := property.History[int](5, property.Guard[int](rule, memory))
+	history.Change(1)
+	if err := history.Undo(); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	if memory.value != 1 {
+		t.Errorf("expected value to stay (1) got (%v)", memory.value)
+	}
+}
@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_inequality_Change_delegates_when_value_is_not_found(t *testing.T) {
+	unwritten := &unwritten[int]{}
+	inequality := property.Inequality[int](unwritten)
+	if err := inequality.Change(0); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if got, err := unwritten.Value(); got != 0 || err != nil {
+		t.Errorf("expected (0, nil) got (%v, %v)", got, err)
+	}
+}
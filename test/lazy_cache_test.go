@@ -72,3 +72,44 @@ func Test_cacheable_Value_delegates_to_underlying_property_after_creation(t *tes
 	//}
 }
 
+
+func Test_lazyCache_Invalidate_makes_Value_delegate_again(t *testing.T){
+	var invoked int
+	delegation := delegate[int]{
+		t:t,
+		value: func() (int, error) {
+			invoked++
+			return invoked, nil
+		},
+	}
+	cache := property.LazyCache[int](delegation)
+	cache.Value()
+	cache.Invalidate()
+	got, _ := cache.Value()
+	if got != 2 {
+		t.Errorf("expected value is (2) got (%v)", got)
+	}
+}
+
+func Test_lazyCache_Refresh_keeps_cache_on_error(t *testing.T){
+	var fail bool
+	delegation := delegate[string]{
+		t:t,
+		value: func() (string, error) {
+			if fail {
+				return "", fmt.Errorf("any error")
+			}
+			return "Go", nil
+		},
+	}
+	cache := property.LazyCache[string](delegation)
+	cache.Value()
+	fail = true
+	if err := cache.Refresh(); err == nil {
+		t.Errorf("expected error of underlying property got (nil)")
+	}
+	got, err := cache.Value()
+	if got != "Go" || err != nil {
+		t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+	}
+}
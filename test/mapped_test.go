@@ -0,0 +1,73 @@
+package test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Map_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Map: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Map[string, int](nil, strconv.Atoi, func(v int) (string, error) { return strconv.Itoa(v), nil })
+}
+
+func Test_mapped_converts_in_both_directions(t *testing.T) {
+	memory := &memory[string]{value: "41"}
+	mapped := property.Map[string, int](memory, strconv.Atoi, func(v int) (string, error) { return strconv.Itoa(v), nil })
+	got, err := mapped.Value()
+	if got != 41 || err != nil {
+		t.Errorf("expected (41, nil) got (%v, %v)", got, err)
+	}
+	mapped.Change(42)
+	if memory.value != "42" {
+		t.Errorf("expected stored value (42) got (%v)", memory.value)
+	}
+}
+
+func Test_mapped_Value_returns_conversion_error(t *testing.T) {
+	mapped := property.Map[string, int](&memory[string]{value: "go"}, strconv.Atoi, func(v int) (string, error) { return strconv.Itoa(v), nil })
+	if _, err := mapped.Value(); err == nil {
+		t.Error("expected conversion error got (nil)")
+	}
+}
+
+func Test_mapped_Change_conversion_error_prevents_delegation(t *testing.T) {
+	from := func(v int) (string, error) {
+		if v < 0 {
+			return "", strconv.ErrRange
+		}
+		return strconv.Itoa(v), nil
+	}
+	mapped := property.Map[string, int](forbidden[string]{t}, strconv.Atoi, from)
+	if err := mapped.Change(-1); err != strconv.ErrRange {
+		t.Errorf("expected error is (%v) got (%v)", strconv.ErrRange, err)
+	}
+}
+
+type account struct {
+	Name  string
+	Email string
+}
+
+func Test_focus_writes_back_modified_copy(t *testing.T) {
+	memory := &memory[account]{value: account{"gopher", "old@go.dev"}}
+	email := property.Focus[account, string](memory,
+		func(a account) string { return a.Email },
+		func(a account, email string) account { a.Email = email; return a },
+	)
+	if got, _ := email.Value(); got != "old@go.dev" {
+		t.Errorf("expected (old@go.dev) got (%v)", got)
+	}
+	email.Change("new@go.dev")
+	expected := account{"gopher", "new@go.dev"}
+	if memory.value != expected {
+		t.Errorf("expected (%v) got (%v)", expected, memory.value)
+	}
+}
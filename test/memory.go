@@ -0,0 +1,16 @@
+package test
+
+// memory is an in-memory property which is not safe for concurrent use,
+// it lets the race detector catch decorators that fail to synchronize.
+type memory[T any] struct {
+	value T
+}
+
+func (m *memory[T]) Change(value T) error {
+	m.value = value
+	return nil
+}
+
+func (m *memory[T]) Value() (T, error) {
+	return m.value, nil
+}
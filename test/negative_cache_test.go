@@ -0,0 +1,120 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_NegativeCache_panic_when_arguments_are_invalid(t *testing.T) {
+	anyProperty := delegate[int]{t: t}
+	table := []struct {
+		initial, max time.Duration
+		clock        property.Clock
+		property     property.Property[int]
+		expected     string
+	}{
+		{0, time.Second, newClock(), anyProperty, "property.NegativeCache: cannot be created unless 0 < initial <= max"},
+		{time.Minute, time.Second, newClock(), anyProperty, "property.NegativeCache: cannot be created unless 0 < initial <= max"},
+		{time.Second, time.Minute, nil, anyProperty, "property.NegativeCache: cannot be created from nil clock"},
+		{time.Second, time.Minute, newClock(), nil, "property.NegativeCache: cannot be created from nil property"},
+	}
+	for _, data := range table {
+		func() {
+			defer func() {
+				got := recover()
+				if got != data.expected {
+					t.Errorf("expected message is (%v) got (%v)", data.expected, got)
+				}
+			}()
+			property.NegativeCache[int](data.initial, data.max, data.clock, data.property)
+		}()
+	}
+}
+
+func Test_negativeCache_Value_returns_cached_error_within_window(t *testing.T) {
+	clock := newClock()
+	expected := fmt.Errorf("any error")
+	var invoked int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			invoked++
+			return 0, expected
+		},
+	}
+	cache := property.NegativeCache[int](time.Second, time.Minute, clock, delegation)
+	if _, err := cache.Value(); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	_, err := cache.Value()
+	if !errors.Is(err, expected) || !errors.Is(err, property.ErrBackoff) {
+		t.Errorf("expected error wrapping (%v) and ErrBackoff got (%v)", expected, err)
+	}
+	if invoked != 1 {
+		t.Errorf("expected one delegation to underlying property got (%v)", invoked)
+	}
+}
+
+func Test_negativeCache_Value_backs_off_exponentially_up_to_max(t *testing.T) {
+	clock := newClock()
+	var invoked int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			invoked++
+			return 0, fmt.Errorf("any error")
+		},
+	}
+	cache := property.NegativeCache[int](time.Second, 3*time.Second, clock, delegation)
+	cache.Value()
+	table := []struct {
+		advance  time.Duration
+		expected int
+	}{
+		{time.Second, 2},     // window was 1s
+		{time.Second, 2},     // window is 2s
+		{time.Second, 3},     // window was 2s
+		{2 * time.Second, 3}, // window is 3s (max)
+		{time.Second, 4},     // window was 3s
+		{3 * time.Second, 5}, // window stays at max
+	}
+	for i, data := range table {
+		clock.Advance(data.advance)
+		cache.Value()
+		if invoked != data.expected {
+			t.Errorf("step %v: expected (%v) delegations got (%v)", i, data.expected, invoked)
+		}
+	}
+}
+
+func Test_negativeCache_success_resets_backoff(t *testing.T) {
+	clock := newClock()
+	fail := true
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			if fail {
+				return "", fmt.Errorf("any error")
+			}
+			return "Go", nil
+		},
+	}
+	cache := property.NegativeCache[string](time.Second, time.Minute, clock, delegation)
+	cache.Value()
+	clock.Advance(time.Second)
+	fail = false
+	got, err := cache.Value()
+	if got != "Go" || err != nil {
+		t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+	}
+	fail = true
+	cache.Value()
+	clock.Advance(time.Second)
+	if _, err := cache.Value(); errors.Is(err, property.ErrBackoff) {
+		t.Errorf("expected window to start again from initial got (%v)", err)
+	}
+}
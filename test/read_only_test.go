@@ -0,0 +1,29 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_readOnly_Change_returns_ErrReadOnly_without_delegation(t *testing.T) {
+	readOnly := property.ReadOnly[int](&memory[int]{value: 1})
+	if err := readOnly.Change(2); err != property.ErrReadOnly {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrReadOnly, err)
+	}
+	if got, _ := readOnly.Value(); got != 1 {
+		t.Errorf("expected value is (1) got (%v)", got)
+	}
+}
+
+func Test_writeOnly_Value_returns_ErrWriteOnly(t *testing.T) {
+	var changed []string
+	writeOnly := property.WriteOnly[string](writer[string]{&changed})
+	writeOnly.Change("secret")
+	if _, err := writeOnly.Value(); err != property.ErrWriteOnly {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrWriteOnly, err)
+	}
+	if len(changed) != 1 || changed[0] != "secret" {
+		t.Errorf("expected [secret] got (%v)", changed)
+	}
+}
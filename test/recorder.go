@@ -0,0 +1,19 @@
+package test
+
+import (
+	"fmt"
+
+	"github.com/begopher/property"
+)
+
+// recorder records every Change sent to property with its name.
+type recorder[T any] struct {
+	property.Property[T]
+	name    string
+	changes *[]string
+}
+
+func (r recorder[T]) Change(value T) error {
+	*r.changes = append(*r.changes, fmt.Sprintf("%v:%v", r.name, value))
+	return r.Property.Change(value)
+}
@@ -0,0 +1,127 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+var errTransient = errors.New("transient")
+
+func isTransient(err error) bool {
+	return errors.Is(err, errTransient)
+}
+
+func Test_func_Retry_panic_when_arguments_are_nil(t *testing.T) {
+	anyPolicy := property.Policy(1, isTransient, property.ConstantBackoff(0))
+	table := []struct {
+		policy   property.RetryPolicy
+		sleeper  property.Sleeper
+		property property.Property[int]
+		expected string
+	}{
+		{nil, &sleeper{}, delegate[int]{t: t}, "property.Retry: cannot be created from nil policy"},
+		{anyPolicy, nil, delegate[int]{t: t}, "property.Retry: cannot be created from nil sleeper"},
+		{anyPolicy, &sleeper{}, nil, "property.Retry: cannot be created from nil property"},
+	}
+	for _, data := range table {
+		func() {
+			defer func() {
+				got := recover()
+				if got != data.expected {
+					t.Errorf("expected message is (%v) got (%v)", data.expected, got)
+				}
+			}()
+			property.Retry[int](data.policy, data.sleeper, data.property)
+		}()
+	}
+}
+
+func Test_retry_Change_retries_transient_errors_until_success(t *testing.T) {
+	var attempts int
+	delegation := delegate[int]{
+		t: t,
+		change: func(int) error {
+			attempts++
+			if attempts < 3 {
+				return errTransient
+			}
+			return nil
+		},
+	}
+	sleeper := &sleeper{}
+	policy := property.Policy(5, isTransient, property.ConstantBackoff(time.Second))
+	retry := property.Retry[int](policy, sleeper, delegation)
+	if err := retry.Change(1); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected (3) attempts got (%v)", attempts)
+	}
+	expected := []time.Duration{time.Second, time.Second}
+	if !reflect.DeepEqual(sleeper.slept, expected) {
+		t.Errorf("expected sleeps (%v) got (%v)", expected, sleeper.slept)
+	}
+}
+
+func Test_retry_Value_stops_at_max_attempts_and_joins_errors(t *testing.T) {
+	var attempts int
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			attempts++
+			return "", fmt.Errorf("attempt %v: %w", attempts, errTransient)
+		},
+	}
+	policy := property.Policy(3, isTransient, property.ConstantBackoff(0))
+	retry := property.Retry[string](policy, &sleeper{}, delegation)
+	_, err := retry.Value()
+	if attempts != 3 {
+		t.Errorf("expected (3) attempts got (%v)", attempts)
+	}
+	expected := "attempt 1: transient\nattempt 2: transient\nattempt 3: transient"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+}
+
+func Test_retry_does_not_retry_permanent_errors(t *testing.T) {
+	expected := fmt.Errorf("permanent")
+	var attempts int
+	delegation := delegate[int]{
+		t: t,
+		change: func(int) error {
+			attempts++
+			return expected
+		},
+	}
+	policy := property.Policy(5, isTransient, property.ConstantBackoff(0))
+	retry := property.Retry[int](policy, &sleeper{}, delegation)
+	if err := retry.Change(1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected (1) attempt got (%v)", attempts)
+	}
+}
+
+func Test_func_ExponentialBackoff_doubles_up_to_max(t *testing.T) {
+	backoff := property.ExponentialBackoff(time.Second, 5*time.Second)
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		if got := backoff(i + 1); got != want {
+			t.Errorf("retry %v: expected (%v) got (%v)", i+1, want, got)
+		}
+	}
+}
+
+func Test_func_JitteredBackoff_scales_underlying_backoff(t *testing.T) {
+	backoff := property.JitteredBackoff(property.ConstantBackoff(time.Second), func() float64 { return 0.25 })
+	if got := backoff(1); got != 250*time.Millisecond {
+		t.Errorf("expected (250ms) got (%v)", got)
+	}
+}
@@ -0,0 +1,203 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_StaleWhileRevalidate_panic_when_arguments_are_invalid(t *testing.T) {
+	anyProperty := delegate[int]{t: t}
+	anyErrs := func(error) {}
+	table := []struct {
+		soft, hard time.Duration
+		clock      property.Clock
+		errs       func(error)
+		property   property.Property[int]
+		expected   string
+	}{
+		{0, time.Second, newClock(), anyErrs, anyProperty, "property.StaleWhileRevalidate: cannot be created unless 0 < soft <= hard"},
+		{time.Minute, time.Second, newClock(), anyErrs, anyProperty, "property.StaleWhileRevalidate: cannot be created unless 0 < soft <= hard"},
+		{time.Second, time.Minute, nil, anyErrs, anyProperty, "property.StaleWhileRevalidate: cannot be created from nil clock"},
+		{time.Second, time.Minute, newClock(), nil, anyProperty, "property.StaleWhileRevalidate: cannot be created from nil errs"},
+		{time.Second, time.Minute, newClock(), anyErrs, nil, "property.StaleWhileRevalidate: cannot be created from nil property"},
+	}
+	for _, data := range table {
+		func() {
+			defer func() {
+				got := recover()
+				if got != data.expected {
+					t.Errorf("expected message is (%v) got (%v)", data.expected, got)
+				}
+			}()
+			property.StaleWhileRevalidate[int](data.soft, data.hard, data.clock, data.errs, data.property)
+		}()
+	}
+}
+
+func Test_staleWhileRevalidate_Value_returns_stale_value_and_refreshes_in_background(t *testing.T) {
+	clock := newClock()
+	called := make(chan struct{}, 2)
+	var loads int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			loads++
+			called <- struct{}{}
+			return loads, nil
+		},
+	}
+	swr := property.StaleWhileRevalidate[int](time.Minute, time.Hour, clock, func(error) {}, delegation)
+	defer swr.Close()
+	if got, _ := swr.Value(); got != 1 {
+		t.Fatalf("expected first Value to load (1) got (%v)", got)
+	}
+	<-called
+	clock.Advance(2 * time.Minute)
+	if got, _ := swr.Value(); got != 1 {
+		t.Errorf("expected stale value (1) got (%v)", got)
+	}
+	<-called
+	swr.Close()
+	if got, _ := swr.Value(); got != 2 {
+		t.Errorf("expected refreshed value (2) got (%v)", got)
+	}
+}
+
+func Test_staleWhileRevalidate_Value_blocks_after_hard_ttl(t *testing.T) {
+	clock := newClock()
+	var loads int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			loads++
+			return loads, nil
+		},
+	}
+	swr := property.StaleWhileRevalidate[int](time.Minute, time.Hour, clock, func(error) {}, delegation)
+	swr.Close()
+	swr.Value()
+	clock.Advance(time.Hour)
+	if got, _ := swr.Value(); got != 2 {
+		t.Errorf("expected value loaded synchronously (2) got (%v)", got)
+	}
+}
+
+func Test_staleWhileRevalidate_background_errors_are_reported_and_value_is_kept(t *testing.T) {
+	clock := newClock()
+	expected := fmt.Errorf("any error")
+	var loads int
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			loads++
+			if loads > 1 {
+				return "", expected
+			}
+			return "Go", nil
+		},
+	}
+	errs := make(chan error, 1)
+	swr := property.StaleWhileRevalidate[string](time.Minute, time.Hour, clock, func(err error) { errs <- err }, delegation)
+	defer swr.Close()
+	swr.Value()
+	clock.Advance(2 * time.Minute)
+	swr.Value()
+	if got := <-errs; got != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, got)
+	}
+	got, err := swr.Value()
+	if got != "Go" || err != nil {
+		t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+	}
+}
+
+func Test_staleWhileRevalidate_Close_is_idempotent(t *testing.T) {
+	swr := property.StaleWhileRevalidate[int](time.Minute, time.Hour, newClock(), func(error) {}, forbidden[int]{t})
+	if err := swr.Close(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if err := swr.Close(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+}
+
+func Test_staleWhileRevalidate_Value_blocked_callers_share_one_load(t *testing.T) {
+	const callers = 16
+	var loads int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			if atomic.AddInt32(&loads, 1) == 1 {
+				close(started)
+				<-release
+			}
+			return 1, nil
+		},
+	}
+	swr := property.StaleWhileRevalidate[int](time.Minute, time.Hour, newClock(), func(error) {}, delegation)
+	defer swr.Close()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		swr.Value()
+	}()
+	<-started
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := swr.Value(); got != 1 || err != nil {
+				t.Errorf("expected (1, nil) got (%v, %v)", got, err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+	if got := atomic.LoadInt32(&loads); got != 1 {
+		t.Errorf("expected one load got (%v)", got)
+	}
+}
+
+func Test_staleWhileRevalidate_errs_may_send_Value(t *testing.T) {
+	clock := newClock()
+	var loads int
+	delegation := delegate[int]{
+		t: t,
+		value: func() (int, error) {
+			loads++
+			if loads == 2 {
+				return 0, fmt.Errorf("any error")
+			}
+			return loads, nil
+		},
+	}
+	got := make(chan int, 1)
+	var swr interface{ Value() (int, error) }
+	errs := func(error) {
+		clock.Advance(time.Hour)
+		value, _ := swr.Value()
+		got <- value
+	}
+	s := property.StaleWhileRevalidate[int](time.Minute, time.Hour, clock, errs, delegation)
+	defer s.Close()
+	swr = s
+	s.Value()
+	clock.Advance(2 * time.Minute)
+	s.Value()
+	select {
+	case value := <-got:
+		if value != 3 {
+			t.Errorf("expected value loaded from errs (3) got (%v)", value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("errs deadlocked sending Value")
+	}
+}
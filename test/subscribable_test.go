@@ -0,0 +1,95 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Subscribable_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Subscribable: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Subscribable[int](func(any) {}, nil)
+}
+
+func Test_subscribable_Change_with_zero_subscribers(t *testing.T) {
+	memory := &memory[int]{}
+	subscribable := property.Subscribable[int](func(any) {}, memory)
+	if err := subscribable.Change(1); err != nil || memory.value != 1 {
+		t.Errorf("expected change to be applied got (%v, %v)", memory.value, err)
+	}
+}
+
+func Test_subscribable_Unsubscribe_stops_notifications(t *testing.T) {
+	var got []string
+	subscribable := property.Subscribable[string](func(any) {}, &memory[string]{})
+	a := subscribable.Subscribe(func(value string) { got = append(got, "a:"+value) })
+	subscribable.Subscribe(func(value string) { got = append(got, "b:"+value) })
+	subscribable.Change("go")
+	a.Unsubscribe()
+	a.Unsubscribe()
+	subscribable.Change("golang")
+	if fmt.Sprint(got) != "[a:go b:go b:golang]" {
+		t.Errorf("expected [a:go b:go b:golang] got (%v)", got)
+	}
+}
+
+func Test_subscribable_Unsubscribe_while_notifying_skips_removed_subscriber(t *testing.T) {
+	var got []string
+	subscribable := property.Subscribable[string](func(any) {}, &memory[string]{})
+	var b property.Subscription
+	subscribable.Subscribe(func(value string) {
+		got = append(got, "a:"+value)
+		b.Unsubscribe()
+	})
+	b = subscribable.Subscribe(func(value string) { got = append(got, "b:"+value) })
+	subscribable.Change("go")
+	if fmt.Sprint(got) != "[a:go]" {
+		t.Errorf("expected [a:go] got (%v)", got)
+	}
+}
+
+func Test_subscribable_panicking_subscriber_is_isolated(t *testing.T) {
+	var recovered []any
+	var notified bool
+	subscribable := property.Subscribable[int](func(v any) { recovered = append(recovered, v) }, &memory[int]{})
+	subscribable.Subscribe(func(int) { panic("boom") })
+	subscribable.Subscribe(func(int) { notified = true })
+	if err := subscribable.Change(1); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if !notified {
+		t.Error("subscriber after the panicking one did not get notified")
+	}
+	if fmt.Sprint(recovered) != "[boom]" {
+		t.Errorf("expected recovered [boom] got (%v)", recovered)
+	}
+}
+
+func Test_subscribable_Subscribe_while_broadcasting(t *testing.T) {
+	subscribable := property.Subscribable[int](func(any) {}, property.Synchronized[int](&memory[int]{}))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				subscribable.Subscribe(func(int) {}).Unsubscribe()
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				subscribable.Change(i*100 + j)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_SyncCache_panic_when_property_is_nil(t *testing.T) {
+	var any int
+	defer func() {
+		got := recover()
+		if got == nil {
+			t.Fatal("passing nil to argument 'property' must cause panic")
+		}
+		expected := "property.SyncCache: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("Expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.SyncCache[int](any, nil)
+}
+
+func Test_syncCache_Value_returns_what_is_in_cache_without_delegation(t *testing.T) {
+	table := []string{"any", "go", "golang"}
+	for _, expected := range table {
+		cache := property.SyncCache[string](expected, forbidden[string]{t})
+		got, err := cache.Value()
+		if got != expected {
+			t.Errorf("Expected value is (%v) got (%v)", expected, got)
+		}
+		if err != nil {
+			t.Errorf("Expected error is (nil) got (%v)", err)
+		}
+	}
+}
+
+func Test_syncCache_Change_updates_cache_only_when_underlying_property_succeed(t *testing.T) {
+	table := []struct {
+		err      error
+		expected string
+	}{
+		{nil, "new"},
+		{fmt.Errorf("any error"), "old"},
+	}
+	for _, data := range table {
+		delegation := delegate[string]{
+			t:      t,
+			change: func(string) error { return data.err },
+		}
+		cache := property.SyncCache[string]("old", delegation)
+		if err := cache.Change("new"); err != data.err {
+			t.Errorf("Expected error is (%v) got (%v)", data.err, err)
+		}
+		got, _ := cache.Value()
+		if got != data.expected {
+			t.Errorf("Expected value is (%v) got (%v)", data.expected, got)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_SyncLazyCache_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		if got == nil {
+			t.Fatal("passing nil to argument 'property' must cause panic")
+		}
+		expected := "property.SyncLazyCache: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.SyncLazyCache[string](nil)
+}
+
+func Test_syncLazyCache_Value_delegates_only_once_after_success(t *testing.T) {
+	var invoked int
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			invoked++
+			return "Go", nil
+		},
+	}
+	cache := property.SyncLazyCache[string](delegation)
+	for i := 0; i < 3; i++ {
+		got, err := cache.Value()
+		if got != "Go" || err != nil {
+			t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+		}
+	}
+	if invoked != 1 {
+		t.Errorf("expected one delegation to underlying property got (%v)", invoked)
+	}
+}
+
+func Test_syncLazyCache_Value_retries_after_error(t *testing.T) {
+	var invoked int
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			invoked++
+			return "", fmt.Errorf("any error")
+		},
+	}
+	cache := property.SyncLazyCache[string](delegation)
+	cache.Value()
+	cache.Value()
+	if invoked != 2 {
+		t.Errorf("expected two delegations to underlying property got (%v)", invoked)
+	}
+}
+
+func Test_syncLazyCache_Change_updates_cache_without_loading(t *testing.T) {
+	delegation := delegate[string]{
+		t:      t,
+		change: func(string) error { return nil },
+	}
+	cache := property.SyncLazyCache[string](delegation)
+	cache.Change("Go")
+	got, _ := cache.Value()
+	if got != "Go" {
+		t.Errorf("expected value is (Go) got (%v)", got)
+	}
+}
+
+func Test_syncLazyCache_Value_concurrent_cold_loads_delegate_once(t *testing.T) {
+	const waiters = 32
+	var invoked int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			if atomic.AddInt32(&invoked, 1) == 1 {
+				close(started)
+			}
+			<-release
+			return "Go", nil
+		},
+	}
+	cache := property.SyncLazyCache[string](delegation)
+	var arrived, wg sync.WaitGroup
+	arrived.Add(waiters)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			arrived.Done()
+			got, err := cache.Value()
+			if got != "Go" || err != nil {
+				t.Errorf("expected (Go, nil) got (%v, %v)", got, err)
+			}
+		}()
+	}
+	// a waiter which reaches the cache after release gets the cached
+	// value, so the assertions hold however goroutines are scheduled
+	<-started
+	arrived.Wait()
+	close(release)
+	wg.Wait()
+	if got := atomic.LoadInt32(&invoked); got != 1 {
+		t.Errorf("expected one delegation to underlying property got (%v)", got)
+	}
+}
+
+func Test_syncLazyCache_Value_concurrent_waiters_share_error_and_cache_stays_empty(t *testing.T) {
+	const waiters = 32
+	var invoked int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	expected := fmt.Errorf("any error")
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			if atomic.AddInt32(&invoked, 1) == 1 {
+				close(started)
+				<-release
+				return "", expected
+			}
+			return "Go", nil
+		},
+	}
+	cache := property.SyncLazyCache[string](delegation)
+	var arrived, wg sync.WaitGroup
+	var failed int32
+	arrived.Add(waiters)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			arrived.Done()
+			got, err := cache.Value()
+			switch {
+			case err == expected:
+				atomic.AddInt32(&failed, 1)
+			case got != "Go" || err != nil:
+				// a waiter which reaches the cache after the failed load
+				// starts the second one and gets its value
+				t.Errorf("expected error (%v) or (Go, nil) got (%v, %v)", expected, got, err)
+			}
+		}()
+	}
+	<-started
+	arrived.Wait()
+	close(release)
+	wg.Wait()
+	if atomic.LoadInt32(&failed) == 0 {
+		t.Errorf("expected the failed load to be reported to its waiters")
+	}
+	got, err := cache.Value()
+	if got != "Go" || err != nil {
+		t.Errorf("expected retry to return (Go, nil) got (%v, %v)", got, err)
+	}
+	if got := atomic.LoadInt32(&invoked); got != 2 {
+		t.Errorf("expected two delegations to underlying property got (%v)", got)
+	}
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/begopher/property"
+	"github.com/begopher/property/x"
+)
+
+// stress sends Change and Value messages to p from many goroutines,
+// it is meant to be run with -race.
+func stress(t *testing.T, p property.Property[int]) {
+	const goroutines = 16
+	const iterations = 200
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if err := p.Change(g*iterations + i); err != nil {
+					t.Errorf("unexpected error (%v)", err)
+					return
+				}
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if _, err := p.Value(); err != nil {
+					t.Errorf("unexpected error (%v)", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_func_Synchronized_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		if got == nil {
+			t.Fatal("passing nil to argument 'property' must cause panic")
+		}
+		expected := "property.Synchronized: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Synchronized[int](nil)
+}
+
+func Test_synchronized_Change_returns_error_of_underlying_property_without_mutation(t *testing.T) {
+	table := []error{
+		nil,
+		fmt.Errorf("any error"),
+	}
+	for _, expected := range table {
+		delegation := delegate[string]{
+			t:      t,
+			change: func(string) error { return expected },
+		}
+		synchronized := property.Synchronized[string](delegation)
+		var any string
+		got := synchronized.Change(any)
+		if got != expected {
+			t.Errorf("expected error is (%v) got (%v)", expected, got)
+		}
+	}
+}
+
+func Test_synchronized_Value_returns_value_and_error_of_underlying_property(t *testing.T) {
+	expectedValue, expectedErr := "Go", fmt.Errorf("any error")
+	delegation := delegate[string]{
+		t: t,
+		value: func() (string, error) {
+			return expectedValue, expectedErr
+		},
+	}
+	synchronized := property.Synchronized[string](delegation)
+	got, err := synchronized.Value()
+	if got != expectedValue {
+		t.Errorf("expected value is (%v) got (%v)", expectedValue, got)
+	}
+	if err != expectedErr {
+		t.Errorf("expected error is (%v) got (%v)", expectedErr, err)
+	}
+}
+
+func Test_synchronized_is_safe_for_concurrent_use(t *testing.T) {
+	stress(t, property.Synchronized[int](&memory[int]{}))
+}
+
+func Test_syncCache_is_safe_for_concurrent_use(t *testing.T) {
+	stress(t, property.SyncCache[int](0, &memory[int]{}))
+}
+
+func Test_syncLazyCache_is_safe_for_concurrent_use(t *testing.T) {
+	stress(t, property.SyncLazyCache[int](&memory[int]{}))
+}
+
+func Test_x_SyncSimple_is_safe_for_concurrent_use(t *testing.T) {
+	stress(t, x.SyncSimple[int](&memory[int]{}))
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Throttle_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Throttle: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Throttle[int](time.Second, newClock(), func(error) {}, nil)
+}
+
+func Test_throttle_sends_at_most_one_change_per_interval(t *testing.T) {
+	clock := newClock()
+	var written []int
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			written = append(written, value)
+			return nil
+		},
+	}
+	throttle := property.Throttle[int](time.Second, clock, func(error) {}, delegation)
+	throttle.Change(1)
+	throttle.Change(2)
+	throttle.Change(3)
+	if fmt.Sprint(written) != "[1]" {
+		t.Errorf("expected first change to be written right away got (%v)", written)
+	}
+	clock.Advance(time.Second)
+	if fmt.Sprint(written) != "[1 3]" {
+		t.Errorf("expected last pending value at end of interval got (%v)", written)
+	}
+	throttle.Change(4)
+	clock.Advance(999 * time.Millisecond)
+	if fmt.Sprint(written) != "[1 3]" {
+		t.Errorf("expected no write within interval got (%v)", written)
+	}
+	clock.Advance(time.Millisecond)
+	if fmt.Sprint(written) != "[1 3 4]" {
+		t.Errorf("expected [1 3 4] got (%v)", written)
+	}
+}
+
+func Test_throttle_Flush_sends_pending_value(t *testing.T) {
+	clock := newClock()
+	memory := &memory[int]{}
+	throttle := property.Throttle[int](time.Second, clock, func(error) {}, memory)
+	throttle.Change(1)
+	throttle.Change(2)
+	if err := throttle.Flush(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if memory.value != 2 {
+		t.Errorf("expected value is (2) got (%v)", memory.value)
+	}
+}
+
+func Test_throttle_keeps_and_retries_value_whose_write_failed(t *testing.T) {
+	clock := newClock()
+	failing := false
+	memory := &memory[int]{}
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			if failing {
+				return fmt.Errorf("any error")
+			}
+			return memory.Change(value)
+		},
+		value: memory.Value,
+	}
+	var reported []error
+	throttle := property.Throttle[int](time.Second, clock, func(err error) { reported = append(reported, err) }, delegation)
+	throttle.Change(1)
+	failing = true
+	throttle.Change(2)
+	clock.Advance(time.Second)
+	if len(reported) != 1 {
+		t.Errorf("expected one reported error got (%v)", reported)
+	}
+	if got, err := throttle.Value(); got != 2 || err != nil {
+		t.Errorf("expected pending (2, nil) got (%v, %v)", got, err)
+	}
+	failing = false
+	clock.Advance(time.Second)
+	if memory.value != 2 {
+		t.Errorf("expected retried value (2) got (%v)", memory.value)
+	}
+}
+
+func Test_throttle_errs_may_send_messages_to_decorator(t *testing.T) {
+	clock := newClock()
+	failing := false
+	delegation := delegate[int]{
+		t: t,
+		change: func(int) error {
+			if failing {
+				return fmt.Errorf("any error")
+			}
+			return nil
+		},
+	}
+	var throttle property.Property[int]
+	var got int
+	th := property.Throttle[int](time.Second, clock, func(error) {
+		got, _ = throttle.Value()
+	}, delegation)
+	throttle = th
+	th.Change(1)
+	failing = true
+	th.Change(2)
+	done := make(chan struct{})
+	go func() {
+		clock.Advance(time.Second)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected errs not to deadlock")
+	}
+	if got != 2 {
+		t.Errorf("expected pending value (2) got (%v)", got)
+	}
+}
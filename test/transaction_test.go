@@ -0,0 +1,65 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Transaction_applies_every_step(t *testing.T) {
+	email := &memory[string]{value: "old@go.dev"}
+	username := &memory[string]{value: "old"}
+	err := property.Transaction(
+		property.ChangeStep[string](email, "new@go.dev"),
+		property.ChangeStep[string](username, "new"),
+	)
+	if err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if email.value != "new@go.dev" || username.value != "new" {
+		t.Errorf("expected (new@go.dev, new) got (%v, %v)", email.value, username.value)
+	}
+}
+
+func Test_func_Transaction_rolls_back_in_reverse_order(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	var restored []string
+	first := &memory[string]{value: "a"}
+	second := &memory[string]{value: "b"}
+	third := &failing[string]{memory: memory[string]{value: "c"}, fail: map[string]error{"C": expected}}
+	err := property.Transaction(
+		property.ChangeStep[string](recorder[string]{first, "first", &restored}, "A"),
+		property.ChangeStep[string](recorder[string]{second, "second", &restored}, "B"),
+		property.ChangeStep[string](third, "C"),
+	)
+	var txErr *property.TransactionError
+	if !errors.As(err, &txErr) || txErr.Step != 2 || txErr.Rollback != nil {
+		t.Fatalf("expected TransactionError of step 2 without rollback errors got (%v)", err)
+	}
+	if !errors.Is(err, expected) {
+		t.Errorf("expected error to wrap (%v) got (%v)", expected, err)
+	}
+	if first.value != "a" || second.value != "b" {
+		t.Errorf("expected (a, b) got (%v, %v)", first.value, second.value)
+	}
+	want := []string{"first:A", "second:B", "second:b", "first:a"}
+	if fmt.Sprint(restored) != fmt.Sprint(want) {
+		t.Errorf("expected changes (%v) got (%v)", want, restored)
+	}
+}
+
+func Test_func_Transaction_reports_rollback_errors(t *testing.T) {
+	original := fmt.Errorf("original")
+	rollback := fmt.Errorf("rollback")
+	first := &failing[string]{memory: memory[string]{value: "a"}, fail: map[string]error{"a": rollback}}
+	second := &failing[string]{memory: memory[string]{value: "b"}, fail: map[string]error{"B": original}}
+	err := property.Transaction(
+		property.ChangeStep[string](first, "A"),
+		property.ChangeStep[string](second, "B"),
+	)
+	if !errors.Is(err, original) || !errors.Is(err, rollback) {
+		t.Errorf("expected error to wrap (%v) and (%v) got (%v)", original, rollback, err)
+	}
+}
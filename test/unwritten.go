@@ -0,0 +1,22 @@
+package test
+
+import "github.com/begopher/property"
+
+// unwritten is an in-memory property which reports property.ErrNotFound
+// until its first Change, like a datasource without a stored value.
+type unwritten[T any] struct {
+	value *T
+}
+
+func (u *unwritten[T]) Change(value T) error {
+	u.value = &value
+	return nil
+}
+
+func (u *unwritten[T]) Value() (T, error) {
+	if u.value == nil {
+		var zero T
+		return zero, property.ErrNotFound
+	}
+	return *u.value, nil
+}
@@ -0,0 +1,33 @@
+package test
+
+import "github.com/begopher/property"
+
+// versionedMemory is an in-memory VersionedDatasource whose version
+// increments on every write.
+type versionedMemory struct {
+	memory[string]
+	version int
+	// interleave runs between Version and ChangeVersion,
+	// it simulates another writer.
+	interleave func()
+}
+
+func (v *versionedMemory) Change(value string) error {
+	v.version++
+	return v.memory.Change(value)
+}
+
+func (v *versionedMemory) Version() (string, int, error) {
+	value, version := v.value, v.version
+	if v.interleave != nil {
+		v.interleave()
+	}
+	return value, version, nil
+}
+
+func (v *versionedMemory) ChangeVersion(version int, value string) error {
+	if version != v.version {
+		return property.ErrConflict
+	}
+	return v.Change(value)
+}
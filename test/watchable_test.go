@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Watchable_panic_when_buffer_is_less_than_one(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Watchable: cannot be created with buffer less than 1"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Watchable[int](0, &memory[int]{})
+}
+
+func Test_watchable_Watch_gets_current_value_then_changes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watchable := property.Watchable[string](8, &memory[string]{value: "go"})
+	ch := watchable.Watch(ctx)
+	watchable.Change("golang")
+	watchable.Change("gopher")
+	var got []string
+	for i := 0; i < 3; i++ {
+		got = append(got, <-ch)
+	}
+	if fmt.Sprint(got) != "[go golang gopher]" {
+		t.Errorf("expected [go golang gopher] got (%v)", got)
+	}
+}
+
+func Test_watchable_slow_consumer_gets_latest_values(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watchable := property.Watchable[int](2, &memory[int]{})
+	ch := watchable.Watch(ctx)
+	for i := 1; i <= 5; i++ {
+		watchable.Change(i)
+	}
+	got := []int{<-ch, <-ch}
+	if fmt.Sprint(got) != "[4 5]" {
+		t.Errorf("expected [4 5] got (%v)", got)
+	}
+}
+
+func Test_watchable_Watch_channel_is_closed_when_context_is_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	watchable := property.Watchable[int](1, &memory[int]{})
+	ch := watchable.Watch(ctx)
+	<-ch
+	cancel()
+	for range ch {
+	}
+	if err := watchable.Change(1); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+}
+
+func Test_watchable_Change_error_is_not_sent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:      t,
+		value:  func() (int, error) { return 0, nil },
+		change: func(int) error { return expected },
+	}
+	watchable := property.Watchable[int](1, delegation)
+	ch := watchable.Watch(ctx)
+	<-ch
+	if err := watchable.Change(1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	select {
+	case v := <-ch:
+		t.Errorf("expected no value got (%v)", v)
+	default:
+	}
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_WithDefault_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.WithDefault: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.WithDefault[int](0, nil)
+}
+
+func Test_withDefault_Value_returns_default_when_not_found(t *testing.T) {
+	table := []struct {
+		err      error
+		expected string
+		expErr   error
+	}{
+		{property.ErrNotFound, "default", nil},
+		{fmt.Errorf("wrapped: %w", property.ErrNotFound), "default", nil},
+		{nil, "stored", nil},
+	}
+	for _, data := range table {
+		delegation := delegate[string]{
+			t:     t,
+			value: func() (string, error) { return "stored", data.err },
+		}
+		got, err := property.WithDefault[string]("default", delegation).Value()
+		if got != data.expected || err != data.expErr {
+			t.Errorf("expected (%v, %v) got (%v, %v)", data.expected, data.expErr, got, err)
+		}
+	}
+}
+
+func Test_withDefault_Value_returns_other_errors(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:     t,
+		value: func() (int, error) { return 0, expected },
+	}
+	if _, err := property.WithDefault[int](1, delegation).Value(); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+}
+
+func Test_lazyCache_caches_default_only_when_placed_above_WithDefault(t *testing.T) {
+	table := []struct {
+		compose  func(property.Property[int]) property.Property[int]
+		expected int
+	}{
+		{func(p property.Property[int]) property.Property[int] {
+			return property.LazyCache[int](property.WithDefault[int](7, p))
+		}, 1},
+		{func(p property.Property[int]) property.Property[int] {
+			return property.WithDefault[int](7, property.LazyCache[int](p))
+		}, 2},
+	}
+	for _, data := range table {
+		var invoked int
+		delegation := delegate[int]{
+			t: t,
+			value: func() (int, error) {
+				invoked++
+				return 0, property.ErrNotFound
+			},
+		}
+		prop := data.compose(delegation)
+		prop.Value()
+		got, _ := prop.Value()
+		if got != 7 {
+			t.Errorf("expected default (7) got (%v)", got)
+		}
+		if invoked != data.expected {
+			t.Errorf("expected (%v) delegations got (%v)", data.expected, invoked)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_WriteBehind_panic_when_arguments_are_invalid(t *testing.T) {
+	table := []struct {
+		interval  time.Duration
+		scheduler property.Scheduler
+		property  property.Property[int]
+		expected  string
+	}{
+		{0, newClock(), delegate[int]{t: t}, "property.WriteBehind: cannot be created with non-positive interval"},
+		{time.Second, nil, delegate[int]{t: t}, "property.WriteBehind: cannot be created from nil scheduler"},
+		{time.Second, newClock(), nil, "property.WriteBehind: cannot be created from nil property"},
+	}
+	for _, data := range table {
+		func() {
+			defer func() {
+				got := recover()
+				if got != data.expected {
+					t.Errorf("expected message is (%v) got (%v)", data.expected, got)
+				}
+			}()
+			property.WriteBehind[int](data.interval, data.scheduler, 0, data.property)
+		}()
+	}
+}
+
+func Test_writeBehind_Change_does_not_delegate_until_Flush(t *testing.T) {
+	var written []string
+	delegation := delegate[string]{
+		t: t,
+		change: func(value string) error {
+			written = append(written, value)
+			return nil
+		},
+	}
+	wb := property.WriteBehind[string](time.Hour, newClock(), "", delegation)
+	defer wb.Close()
+	wb.Change("go")
+	wb.Change("golang")
+	if got, _ := wb.Value(); got != "golang" {
+		t.Errorf("expected value is (golang) got (%v)", got)
+	}
+	if len(written) != 0 {
+		t.Errorf("expected no delegation before Flush got (%v)", written)
+	}
+	if err := wb.Flush(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	wb.Flush()
+	if len(written) != 1 || written[0] != "golang" {
+		t.Errorf("expected coalesced write [golang] got (%v)", written)
+	}
+}
+
+func Test_writeBehind_Close_flushes_and_returns_undelivered_errors(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:      t,
+		change: func(int) error { return expected },
+	}
+	wb := property.WriteBehind[int](time.Hour, newClock(), 0, delegation)
+	wb.Change(1)
+	if err := wb.Close(); !errors.Is(err, expected) {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	if err := wb.Change(2); err != property.ErrClosed {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrClosed, err)
+	}
+}
+
+func Test_writeBehind_writes_on_interval(t *testing.T) {
+	clock := newClock()
+	var written []int
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			written = append(written, value)
+			return nil
+		},
+	}
+	wb := property.WriteBehind[int](time.Second, clock, 0, delegation)
+	defer wb.Close()
+	wb.Change(7)
+	clock.Advance(500 * time.Millisecond)
+	if len(written) != 0 {
+		t.Errorf("expected no write before interval got (%v)", written)
+	}
+	clock.Advance(500 * time.Millisecond)
+	wb.Change(8)
+	clock.Advance(time.Second)
+	if fmt.Sprint(written) != "[7 8]" {
+		t.Errorf("expected [7 8] got (%v)", written)
+	}
+}
+
+func Test_writeBehind_keeps_one_error_per_failed_version(t *testing.T) {
+	clock := newClock()
+	var attempts int
+	delegation := delegate[int]{
+		t: t,
+		change: func(value int) error {
+			attempts++
+			return fmt.Errorf("version %v attempt %v failed", value, attempts)
+		},
+	}
+	wb := property.WriteBehind[int](time.Second, clock, 0, delegation)
+	wb.Change(1)
+	clock.Advance(3 * time.Second)
+	wb.Change(2)
+	clock.Advance(3 * time.Second)
+	err := wb.Close()
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected joined errors got (%v)", err)
+	}
+	var got []string
+	for _, err := range joined.Unwrap() {
+		got = append(got, err.Error())
+	}
+	expected := "[version 1 attempt 1 failed version 2 attempt 4 failed]"
+	if fmt.Sprint(got) != expected {
+		t.Errorf("expected (%v) got (%v)", expected, got)
+	}
+}
+
+func Test_writeBehind_Flush_delivers_errors_once(t *testing.T) {
+	clock := newClock()
+	expected := fmt.Errorf("any error")
+	delegation := delegate[int]{
+		t:      t,
+		change: func(int) error { return expected },
+	}
+	wb := property.WriteBehind[int](time.Second, clock, 0, delegation)
+	wb.Change(1)
+	clock.Advance(time.Second)
+	if err := wb.Flush(); !errors.Is(err, expected) {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+	clock.Advance(3 * time.Second)
+	if err := wb.Close(); !errors.Is(err, expected) {
+		t.Errorf("expected error of Close flush is (%v) got (%v)", expected, err)
+	}
+}
+
+func Test_func_WriteBehind_accepts_writer(t *testing.T) {
+	var changed []int
+	wb := property.WriteBehind[int](time.Hour, newClock(), 0, writer[int]{&changed})
+	wb.Change(1)
+	wb.Close()
+	if len(changed) != 1 || changed[0] != 1 {
+		t.Errorf("expected [1] got (%v)", changed)
+	}
+}
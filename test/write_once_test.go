@@ -0,0 +1,78 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_WriteOnce_panic_when_unset_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.WriteOnce: cannot be created from nil unset"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.WriteOnce[int](nil, &memory[int]{})
+}
+
+func Test_writeOnce_allows_first_change_only(t *testing.T) {
+	memory := &memory[string]{}
+	once := property.WriteOnce[string](property.ZeroUnset[string](), memory)
+	if err := once.Change("tenant-1"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if err := once.Change("tenant-2"); err != property.ErrAlreadySet {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrAlreadySet, err)
+	}
+	if memory.value != "tenant-1" {
+		t.Errorf("expected value is (tenant-1) got (%v)", memory.value)
+	}
+}
+
+func Test_writeOnce_rejects_value_stored_by_earlier_process(t *testing.T) {
+	memory := &memory[string]{value: "tenant-1"}
+	once := property.WriteOnce[string](property.ZeroUnset[string](), memory)
+	if err := once.Change("tenant-2"); err != property.ErrAlreadySet {
+		t.Errorf("expected error is (%v) got (%v)", property.ErrAlreadySet, err)
+	}
+}
+
+func Test_writeOnce_ZeroUnset_treats_not_found_as_unset(t *testing.T) {
+	once := property.WriteOnce[string](property.ZeroUnset[string](), &unwritten[string]{})
+	if err := once.Change("tenant-1"); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if got, _ := once.Value(); got != "tenant-1" {
+		t.Errorf("expected value is (tenant-1) got (%v)", got)
+	}
+}
+
+func Test_writeOnce_not_found_error_means_unset(t *testing.T) {
+	notFound := errors.New("not found")
+	var changed bool
+	delegation := delegate[int]{
+		t:      t,
+		value:  func() (int, error) { return 0, fmt.Errorf("read: %w", notFound) },
+		change: func(int) error { changed = true; return nil },
+	}
+	once := property.WriteOnce[int](property.ErrorUnset[int](notFound), delegation)
+	if err := once.Change(1); err != nil || !changed {
+		t.Errorf("expected change to be delegated got (%v, %v)", changed, err)
+	}
+}
+
+func Test_writeOnce_returns_read_error_which_does_not_mean_unset(t *testing.T) {
+	expected := errors.New("connection refused")
+	delegation := delegate[int]{
+		t:     t,
+		value: func() (int, error) { return 0, expected },
+	}
+	once := property.WriteOnce[int](property.ErrorUnset[int](errors.New("not found")), delegation)
+	if err := once.Change(1); err != expected {
+		t.Errorf("expected error is (%v) got (%v)", expected, err)
+	}
+}
@@ -0,0 +1,11 @@
+package test
+
+// writer has only the write capability of a property.
+type writer[T any] struct {
+	changed *[]T
+}
+
+func (w writer[T]) Change(value T) error {
+	*w.changed = append(*w.changed, value)
+	return nil
+}
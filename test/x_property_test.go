@@ -0,0 +1,53 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/begopher/property"
+	"github.com/begopher/property/x"
+)
+
+func Test_x_property_Invalidate_makes_Value_read_datasource(t *testing.T) {
+	datasource := &memory[string]{value: "old"}
+	prop := x.Simple[string](datasource)
+	prop.Value()
+	datasource.value = "new"
+	prop.Invalidate()
+	got, _ := prop.Value()
+	if got != "new" {
+		t.Errorf("expected value is (new) got (%v)", got)
+	}
+}
+
+func Test_x_property_Refresh_reads_datasource(t *testing.T) {
+	datasource := &memory[string]{value: "old"}
+	prop := x.Simple[string](datasource)
+	prop.Value()
+	datasource.value = "new"
+	if err := prop.Refresh(); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	got, _ := prop.Value()
+	if got != "new" {
+		t.Errorf("expected value is (new) got (%v)", got)
+	}
+}
+
+func Test_x_property_Change_when_datasource_reports_not_found(t *testing.T) {
+	var changed bool
+	datasource := delegate[string]{
+		t:      t,
+		value:  func() (string, error) { return "", property.ErrNotFound },
+		change: func(string) error { changed = true; return nil },
+	}
+	prop := x.Simple[string](datasource)
+	if err := prop.Change(""); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if _, err := prop.Value(); err != nil {
+		t.Errorf("expected cached value after change got error (%v)", err)
+	}
+	if !changed {
+		t.Error("zero value was not written to datasource reporting not found")
+	}
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/begopher/property"
+)
+
+func Test_func_Zip2_panic_when_property_is_nil(t *testing.T) {
+	defer func() {
+		got := recover()
+		expected := "property.Zip2: cannot be created from nil property"
+		if got != expected {
+			t.Errorf("expected message is (%v) got (%v)", expected, got)
+		}
+	}()
+	property.Zip2[int, string](&memory[int]{}, nil)
+}
+
+func Test_zip2_Value_reads_both_properties(t *testing.T) {
+	zip := property.Zip2[int, string](&memory[int]{value: 1}, &memory[string]{value: "go"})
+	got, err := zip.Value()
+	expected := property.Pair[int, string]{First: 1, Second: "go"}
+	if got != expected || err != nil {
+		t.Errorf("expected (%v, nil) got (%v, %v)", expected, got, err)
+	}
+}
+
+func Test_zip2_Change_rolls_back_first_when_second_fails(t *testing.T) {
+	expected := fmt.Errorf("any error")
+	first := &memory[int]{value: 1}
+	second := &failing[string]{memory: memory[string]{value: "go"}, fail: map[string]error{"bad": expected}}
+	zip := property.Zip2[int, string](first, second)
+	err := zip.Change(property.Pair[int, string]{First: 2, Second: "bad"})
+	if !errors.Is(err, expected) {
+		t.Errorf("expected error to wrap (%v) got (%v)", expected, err)
+	}
+	if first.value != 1 {
+		t.Errorf("expected first to be rolled back to (1) got (%v)", first.value)
+	}
+}
+
+func Test_zip2_Change_writes_properties_which_were_never_written(t *testing.T) {
+	first, second := &unwritten[int]{}, &unwritten[string]{}
+	zip := property.Zip2[int, string](first, second)
+	expected := property.Pair[int, string]{First: 1, Second: "go"}
+	if err := zip.Change(expected); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if got, _ := zip.Value(); got != expected {
+		t.Errorf("expected (%v) got (%v)", expected, got)
+	}
+}
+
+func Test_zip3_Change_writes_all_properties(t *testing.T) {
+	a, b, c := &memory[int]{}, &memory[string]{}, &memory[bool]{}
+	zip := property.Zip3[int, string, bool](a, b, c)
+	expected := property.Triple[int, string, bool]{First: 1, Second: "go", Third: true}
+	if err := zip.Change(expected); err != nil {
+		t.Errorf("expected error is (nil) got (%v)", err)
+	}
+	if got, _ := zip.Value(); got != expected {
+		t.Errorf("expected (%v) got (%v)", expected, got)
+	}
+}
@@ -0,0 +1,150 @@
+package property
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle implements Property[T any] interface which sends at most one
+// Change per interval d to underlying property. It is safe for concurrent use.
+//
+// messages:
+//   - Change is delegated right away when no write happened during the last
+//     d, otherwise value is kept as pending and the last pending value is
+//     sent once the interval ends.
+//   - Value  returns pending value and nil if any, otherwise it delegates to
+//     underlying property.
+//   - Flush  sends pending value to underlying property right away.
+//
+// Errors of delayed writes are reported to errs after the internal lock is
+// released, so errs may send messages to the decorator; errors of writes
+// made right away are returned by Change. A delayed value whose write failed
+// stays pending, Value keeps returning it and the write is retried after d
+// unless a newer Change replaces it.
+//
+// panic when:
+//   - d is not positive.
+//   - scheduler is nil.
+//   - errs is nil.
+//   - property is nil.
+func Throttle[T any](d time.Duration, scheduler Scheduler, errs func(error), property Property[T]) *throttle[T] {
+	if d <= 0 {
+		panic("property.Throttle: cannot be created with non-positive duration")
+	}
+	if scheduler == nil {
+		panic("property.Throttle: cannot be created from nil scheduler")
+	}
+	if errs == nil {
+		panic("property.Throttle: cannot be created from nil errs")
+	}
+	if property == nil {
+		panic("property.Throttle: cannot be created from nil property")
+	}
+	return &throttle[T]{
+		d:         d,
+		scheduler: scheduler,
+		errs:      errs,
+		property:  property,
+	}
+}
+
+// throttle implements Property[T any] interface
+type throttle[T any] struct {
+	mu        sync.Mutex
+	d         time.Duration
+	scheduler Scheduler
+	errs      func(error)
+	pending   *T
+	// written is when the last write was sent, zero before the first one
+	written time.Time
+	stop    func() bool
+	// generation tells a fired timer whether it is still the current one
+	generation uint64
+	property   Property[T]
+}
+
+// Change message delegates to underlying property when interval allows,
+// otherwise value is kept as pending.
+//
+// Error of underlying property is returned when it is delegated right away.
+func (t *throttle[T]) Change(value T) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.scheduler.Now()
+	if t.stop == nil && (t.written.IsZero() || !now.Before(t.written.Add(t.d))) {
+		t.written = now
+		return t.property.Change(value)
+	}
+	t.pending = &value
+	if t.stop == nil {
+		t.schedule(now)
+	}
+	return nil
+}
+
+// Value message returns pending value and nil if any,
+// otherwise it delegates to underlying property.
+func (t *throttle[T]) Value() (T, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pending != nil {
+		return *t.pending, nil
+	}
+	return t.property.Value()
+}
+
+// Flush message sends pending value to underlying property right away.
+//
+// Error of underlying property is returned, the value stays pending.
+func (t *throttle[T]) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stop != nil {
+		t.stop()
+		t.stop = nil
+	}
+	t.generation++
+	return t.write()
+}
+
+func (t *throttle[T]) fire(generation uint64) {
+	t.mu.Lock()
+	if generation != t.generation {
+		t.mu.Unlock()
+		return
+	}
+	t.stop = nil
+	err := t.write()
+	t.mu.Unlock()
+	if err != nil {
+		t.errs(err)
+	}
+}
+
+// schedule must be called while holding mu, it sends pending value
+// once the interval of the last write ends.
+func (t *throttle[T]) schedule(now time.Time) {
+	if t.stop != nil {
+		t.stop()
+	}
+	t.generation++
+	generation := t.generation
+	t.stop = t.scheduler.AfterFunc(t.written.Add(t.d).Sub(now), func() {
+		t.fire(generation)
+	})
+}
+
+// write must be called while holding mu, pending value is kept
+// and a retry is scheduled when underlying property fails.
+func (t *throttle[T]) write() error {
+	if t.pending == nil {
+		return nil
+	}
+	t.written = t.scheduler.Now()
+	if err := t.property.Change(*t.pending); err != nil {
+		t.schedule(t.written)
+		return err
+	}
+	t.pending = nil
+	return nil
+}
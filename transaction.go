@@ -0,0 +1,111 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Step is a change of a single property within Transaction,
+// see ChangeStep to create one.
+//
+// A Step is single-use, it records the old value of its property when it
+// is applied, so it must not be passed to more than one Transaction nor to
+// concurrent ones.
+type Step interface {
+	apply() error
+	rollback() error
+}
+
+// ChangeStep returns Step which changes property to value,
+// old value of property is recorded through Value before the change.
+// When Value reports ErrNotFound the zero value of T is recorded as old
+// value, so rollback writes zero since a datasource cannot be unwritten.
+//
+// # Panic when property argument is nil
+func ChangeStep[T any](property Property[T], value T) Step {
+	if property == nil {
+		panic("property.ChangeStep: cannot be created from nil property")
+	}
+	return &changeStep[T]{
+		property: property,
+		value:    value,
+	}
+}
+
+type changeStep[T any] struct {
+	property Property[T]
+	value    T
+	old      T
+}
+
+func (s *changeStep[T]) apply() error {
+	old, err := s.property.Value()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	s.old = old
+	return s.property.Change(s.value)
+}
+
+func (s *changeStep[T]) rollback() error {
+	return s.property.Change(s.old)
+}
+
+// TransactionError is returned by Transaction when a step fails.
+type TransactionError struct {
+	// Step is the index of the failed step.
+	Step int
+	// Err is the error of the failed step.
+	Err error
+	// Rollback holds errors of steps that could not be restored,
+	// it is nil when rollback succeeded.
+	Rollback error
+}
+
+func (e *TransactionError) Error() string {
+	if e.Rollback == nil {
+		return fmt.Sprintf("property.Transaction: step %d: %v", e.Step, e.Err)
+	}
+	return fmt.Sprintf("property.Transaction: step %d: %v; rollback: %v", e.Step, e.Err, e.Rollback)
+}
+
+// Unwrap returns both the error of the failed step and rollback errors,
+// so errors.Is and errors.As match either of them.
+func (e *TransactionError) Unwrap() []error {
+	if e.Rollback == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Err, e.Rollback}
+}
+
+// Transaction applies steps in order, when a step fails the steps
+// applied before it are restored to their old values in reverse order.
+//
+// It returns nil when every step succeeds, otherwise *TransactionError.
+// Transaction is not isolated, other writers may observe the intermediate
+// values and a concurrent write to a property may be overwritten by rollback.
+//
+// # Panic when any step is nil, before any step is applied
+func Transaction(steps ...Step) error {
+	for _, s := range steps {
+		if s == nil {
+			panic("property.Transaction: cannot be applied with nil step")
+		}
+	}
+	for i, s := range steps {
+		if err := s.apply(); err != nil {
+			var errs []error
+			for j := i - 1; j >= 0; j-- {
+				if err := steps[j].rollback(); err != nil {
+					errs = append(errs, fmt.Errorf("step %d: %w", j, err))
+				}
+			}
+			return &TransactionError{
+				Step:     i,
+				Err:      err,
+				Rollback: errors.Join(errs...),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package property
+
+// VersionedDatasource is implemented by stores which attach a version
+// (revision number, ETag, ...) to their value and can check it on write.
+type VersionedDatasource[T any, V comparable] interface {
+	Property[T]
+	// Version returns current value along with its version.
+	Version() (T, V, error)
+	// ChangeVersion changes value only if current version equals version,
+	// otherwise it returns ErrConflict.
+	ChangeVersion(version V, value T) error
+}
+
+// Versioned returns implementation of CompareAndChanger[T comparable]
+// interface which pushes the check down to datasource, so CompareAndChange
+// holds even when other processes write to the same store.
+//
+// # Panic when datasource argument is nil
+func Versioned[T comparable, V comparable](datasource VersionedDatasource[T, V]) versioned[T, V] {
+	if datasource == nil {
+		panic("property.Versioned: cannot be created from nil datasource")
+	}
+	return versioned[T, V]{datasource}
+}
+
+// versioned implements CompareAndChanger[T comparable] interface
+type versioned[T comparable, V comparable] struct {
+	datasource VersionedDatasource[T, V]
+}
+
+// Change message delegates to datasource unconditionally.
+func (v versioned[T, V]) Change(value T) error {
+	return v.datasource.Change(value)
+}
+
+// Value message returns actual value by delegation to datasource.
+func (v versioned[T, V]) Value() (T, error) {
+	return v.datasource.Value()
+}
+
+// CompareAndChange message reads current value and version from datasource,
+// when value equals expected the change is sent along with that version so
+// datasource rejects it if another writer got in between.
+//
+// ErrConflict or error of datasource is returned.
+func (v versioned[T, V]) CompareAndChange(expected, value T) error {
+	current, version, err := v.datasource.Version()
+	if err != nil {
+		return err
+	}
+	if current != expected {
+		return ErrConflict
+	}
+	return v.datasource.ChangeVersion(version, value)
+}
@@ -0,0 +1,103 @@
+package property
+
+import (
+	"context"
+	"sync"
+)
+
+// Watchable returns implementation of Property[T any] interface which lets
+// goroutines watch changes through channels, it is safe for concurrent use.
+//
+// Every channel returned by Watch holds up to buffer values. When a consumer
+// is slow and its channel is full, the oldest pending value is discarded so
+// the latest value always gets through (latest value wins).
+//
+// panic when:
+//   - buffer is less than 1.
+//   - property is nil.
+func Watchable[T any](buffer int, property Property[T]) *watchable[T] {
+	if buffer < 1 {
+		panic("property.Watchable: cannot be created with buffer less than 1")
+	}
+	if property == nil {
+		panic("property.Watchable: cannot be created from nil property")
+	}
+	return &watchable[T]{
+		buffer:   buffer,
+		watchers: map[chan T]struct{}{},
+		property: property,
+	}
+}
+
+type watchable[T any] struct {
+	// mu serializes changes and guards watchers, so every watcher
+	// gets values in change order
+	mu       sync.Mutex
+	buffer   int
+	watchers map[chan T]struct{}
+	property Property[T]
+}
+
+// Change message delegates to underlying property to update itself
+// when no error occur, the value is sent to every watcher.
+//
+// Error of underlying property is returned.
+func (w *watchable[T]) Change(value T) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.property.Change(value); err != nil {
+		return err
+	}
+	for ch := range w.watchers {
+		w.send(ch, value)
+	}
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (w *watchable[T]) Value() (T, error) {
+	return w.property.Value()
+}
+
+// Watch message returns a channel which first gets current value and then
+// every later successful change, until ctx is done and the channel is closed.
+// When current value cannot be read, the channel starts with the next change.
+//
+// # Panic when ctx is nil
+func (w *watchable[T]) Watch(ctx context.Context) <-chan T {
+	if ctx == nil {
+		panic("property.Watchable: cannot watch with nil context")
+	}
+	ch := make(chan T, w.buffer)
+	w.mu.Lock()
+	if value, err := w.property.Value(); err == nil {
+		ch <- value
+	}
+	w.watchers[ch] = struct{}{}
+	w.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.watchers, ch)
+		close(ch)
+	}()
+	return ch
+}
+
+// send must be called while holding mu, it never blocks.
+func (w *watchable[T]) send(ch chan T, value T) {
+	for {
+		select {
+		case ch <- value:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package property
+
+import "errors"
+
+// ErrNotFound is returned by Value of a datasource which has never been
+// written, so callers can tell a missing value from a zero one. Datasources
+// may wrap it, decorators check it with errors.Is.
+var ErrNotFound = errors.New("property: not found")
+
+// WithDefault implements Property[T any] interface which returns def when
+// underlying property reports ErrNotFound.
+//
+// LazyCache caches only successful loads, it never caches ErrNotFound.
+// Placing WithDefault beneath LazyCache caches the default until the first
+// Change, while placing it above LazyCache retries the datasource on every
+// Value until it has a value:
+//
+//	property.LazyCache[T](property.WithDefault[T](def, datasource)) // default is cached
+//	property.WithDefault[T](def, property.LazyCache[T](datasource)) // datasource is retried
+//
+// # Panic when property argument is nil
+func WithDefault[T any](def T, property Property[T]) withDefault[T] {
+	if property == nil {
+		panic("property.WithDefault: cannot be created from nil property")
+	}
+	return withDefault[T]{
+		def:      def,
+		property: property,
+	}
+}
+
+// withDefault implements Property[T any] interface
+type withDefault[T any] struct {
+	def      T
+	property Property[T]
+}
+
+// Change message delegates to underlying property.
+//
+// Error of underlying property is returned.
+func (w withDefault[T]) Change(value T) error {
+	return w.property.Change(value)
+}
+
+// Value message delegates to underlying property, def and nil are
+// returned when it reports ErrNotFound.
+//
+// Any other error of underlying property is returned.
+func (w withDefault[T]) Value() (T, error) {
+	value, err := w.property.Value()
+	if errors.Is(err, ErrNotFound) {
+		return w.def, nil
+	}
+	return value, err
+}
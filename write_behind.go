@@ -0,0 +1,198 @@
+package property
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by decorators which run background goroutines
+// when they receive a message after Close.
+var ErrClosed = errors.New("property: closed")
+
+// WriteBehind implements Property[T any] interface which saves value in
+// memory and writes it to underlying property later, it is safe for
+// concurrent use. Like Cache, value must be provided at construction time.
+// Underlying property is only written to, so any Writer[T any] will do.
+//
+// messages:
+//   - Change updates in-memory value and returns at once, it returns
+//     ErrClosed after Close.
+//   - Value  returns in-memory value and nil.
+//   - Flush  writes in-memory value to underlying property if it has changed
+//     since the last successful write.
+//   - Close  stops background writes, flushes what is left and returns
+//     every write error which was never delivered.
+//
+// Writes are coalesced, only the latest value is sent to underlying property,
+// every interval or when Flush is received. A failed write is retried on the
+// next interval. One error is kept for every value whose write failed, retries
+// of the same value do not record it again, until Flush or Close delivers them.
+//
+// panic when:
+//   - interval is not positive.
+//   - scheduler is nil.
+//   - property is nil.
+func WriteBehind[T any](interval time.Duration, scheduler Scheduler, value T, property Writer[T]) *writeBehind[T] {
+	if interval <= 0 {
+		panic("property.WriteBehind: cannot be created with non-positive interval")
+	}
+	if scheduler == nil {
+		panic("property.WriteBehind: cannot be created from nil scheduler")
+	}
+	if property == nil {
+		panic("property.WriteBehind: cannot be created from nil property")
+	}
+	w := &writeBehind[T]{
+		interval:  interval,
+		scheduler: scheduler,
+		value:     value,
+		property:  property,
+	}
+	w.mu.Lock()
+	w.schedule()
+	w.mu.Unlock()
+	return w
+}
+
+// writeBehind implements Property[T any] interface
+type writeBehind[T any] struct {
+	// writing serializes writes sent to property
+	writing sync.Mutex
+	// mu guards value, version, dirty, closed, stop, failures and failed
+	mu        sync.Mutex
+	interval  time.Duration
+	scheduler Scheduler
+	value     T
+	version   uint64
+	dirty     bool
+	closed    bool
+	stop      func() bool
+	// failures holds undelivered write errors, one per failed version
+	failures []failure
+	// failed is the latest version whose write failure was recorded
+	failed uint64
+	// ticks tracks background writes in flight, Close waits for them
+	ticks    sync.WaitGroup
+	property Writer[T]
+}
+
+// failure is an undelivered error of writing version.
+type failure struct {
+	version uint64
+	err     error
+}
+
+// Change message updates in-memory value, underlying property
+// receives it on the next write.
+func (w *writeBehind[T]) Change(value T) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return ErrClosed
+	}
+	w.value = value
+	w.version++
+	w.dirty = true
+	return nil
+}
+
+// Value message returns in-memory value and nil.
+// No delegation occurs to underlying property.
+func (w *writeBehind[T]) Value() (T, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.value, nil
+}
+
+// Flush message writes in-memory value to underlying property.
+//
+// Undelivered errors of background writes and error of this write are
+// returned joined together, an error of a version which is already
+// undelivered is not returned twice.
+func (w *writeBehind[T]) Flush() error {
+	version, err := w.flush()
+	w.mu.Lock()
+	if err != nil {
+		if n := len(w.failures); n == 0 || w.failures[n-1].version != version {
+			w.failures = append(w.failures, failure{version, err})
+		}
+		w.failed = version
+	}
+	failures := w.failures
+	w.failures = nil
+	w.mu.Unlock()
+	errs := make([]error, len(failures))
+	for i, f := range failures {
+		errs[i] = f.err
+	}
+	return errors.Join(errs...)
+}
+
+// Close message stops background writes, waits for one in flight
+// and flushes what is left.
+//
+// Undelivered write errors are returned joined together, see Flush.
+func (w *writeBehind[T]) Close() error {
+	w.mu.Lock()
+	w.closed = true
+	if w.stop != nil {
+		w.stop()
+		w.stop = nil
+	}
+	w.mu.Unlock()
+	w.ticks.Wait()
+	return w.Flush()
+}
+
+// schedule must be called while holding mu.
+func (w *writeBehind[T]) schedule() {
+	w.stop = w.scheduler.AfterFunc(w.interval, w.tick)
+}
+
+// tick writes in the background and schedules the next one, a failure
+// is recorded once per version.
+func (w *writeBehind[T]) tick() {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	w.ticks.Add(1)
+	w.mu.Unlock()
+	defer w.ticks.Done()
+	version, err := w.flush()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil && version != w.failed {
+		w.failed = version
+		w.failures = append(w.failures, failure{version, err})
+	}
+	if !w.closed {
+		w.schedule()
+	}
+}
+
+// flush sends in-memory value to underlying property when it is dirty and
+// returns the version it wrote, value stays dirty if a Change happened
+// while writing.
+func (w *writeBehind[T]) flush() (uint64, error) {
+	w.writing.Lock()
+	defer w.writing.Unlock()
+	w.mu.Lock()
+	if !w.dirty {
+		w.mu.Unlock()
+		return 0, nil
+	}
+	value, version := w.value, w.version
+	w.mu.Unlock()
+	if err := w.property.Change(value); err != nil {
+		return version, err
+	}
+	w.mu.Lock()
+	if w.version == version {
+		w.dirty = false
+	}
+	w.mu.Unlock()
+	return version, nil
+}
@@ -0,0 +1,101 @@
+package property
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrAlreadySet is returned by WriteOnce when value has already been set.
+var ErrAlreadySet = errors.New("property: already set")
+
+// ZeroUnset returns unset predicate for WriteOnce which considers
+// the zero value of T, or ErrNotFound, as unset.
+func ZeroUnset[T comparable]() func(T, error) bool {
+	return func(value T, err error) bool {
+		if errors.Is(err, ErrNotFound) {
+			return true
+		}
+		var zero T
+		return err == nil && value == zero
+	}
+}
+
+// ErrorUnset returns unset predicate for WriteOnce which considers value
+// unset when reading it fails with target (see errors.Is), such as a
+// not-found error of the datasource.
+//
+// # Panic when target is nil
+func ErrorUnset[T any](target error) func(T, error) bool {
+	if target == nil {
+		panic("property.ErrorUnset: cannot be created from nil target")
+	}
+	return func(_ T, err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
+// WriteOnce implements Property[T any] interface whose value may be set once
+// and never changed, such as an account creation date. It is safe for
+// concurrent use.
+//
+// Before the first change, current value is read through underlying property
+// and passed along with its error to unset. Change is delegated only when
+// unset returns true, so a value which has already been stored, even by an
+// earlier process, is never overwritten. Once a change succeeds, later ones
+// are rejected without reading underlying property.
+//
+// panic when:
+//   - unset is nil.
+//   - property is nil.
+func WriteOnce[T any](unset func(T, error) bool, property Property[T]) *writeOnce[T] {
+	if unset == nil {
+		panic("property.WriteOnce: cannot be created from nil unset")
+	}
+	if property == nil {
+		panic("property.WriteOnce: cannot be created from nil property")
+	}
+	return &writeOnce[T]{
+		unset:    unset,
+		property: property,
+	}
+}
+
+// writeOnce implements Property[T any] interface
+type writeOnce[T any] struct {
+	mu       sync.Mutex
+	unset    func(T, error) bool
+	set      bool
+	property Property[T]
+}
+
+// Change message delegates to underlying property only when value is unset.
+//
+// ErrAlreadySet, error of reading current value which does not mean unset,
+// or error of underlying property is returned.
+func (w *writeOnce[T]) Change(value T) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.set {
+		return ErrAlreadySet
+	}
+	current, err := w.property.Value()
+	if !w.unset(current, err) {
+		if err != nil {
+			return err
+		}
+		w.set = true
+		return ErrAlreadySet
+	}
+	if err := w.property.Change(value); err != nil {
+		return err
+	}
+	w.set = true
+	return nil
+}
+
+// Value message returns actual value by delegation to underlying property.
+//
+// Error of underlying property is returned.
+func (w *writeOnce[T]) Value() (T, error) {
+	return w.property.Value()
+}
@@ -1,5 +1,7 @@
 package x
 
+// Datasource stores and retrieves value of a property, Value should
+// report property.ErrNotFound when nothing has been stored yet.
 type Datasource[T any] interface {
 	Change(T) error
 	Value() (T, error)
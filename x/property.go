@@ -1,6 +1,9 @@
 package x
 
 import(
+	"errors"
+
+	root "github.com/begopher/property"
 	"github.com/begopher/rule"
 	"github.com/begopher/rule/constraints"
 	"github.com/begopher/event"
@@ -12,6 +15,8 @@ type Property[T any] interface{
 	Value() (T, error)
 	Constraints(rule.Constraint[T])
 	Cache(T)
+	Invalidate()
+	Refresh() error
 	Publish(int, rule.Rule[T]) bool
 	Unpublish(int) bool
 	Bind(int, event.Registration) error
@@ -23,6 +28,10 @@ func Simple[T comparable](datasource Datasource[T]) Property[T] {
 	if datasource == nil {
 		panic("property.Simple: datasource cannot be nil")
 	}
+	return newProperty(datasource)
+}
+
+func newProperty[T comparable](datasource Datasource[T]) *property[T] {
 	return &property[T]{
 		datasource: datasource,
 		cons: constraints.New[T](),
@@ -30,6 +39,7 @@ func Simple[T comparable](datasource Datasource[T]) Property[T] {
 		dispatcher: dispatcher.New(),
 	}
 }
+
 type property[T comparable] struct{
 	// datasource to store and retrive value from
 	datasource Datasource[T]
@@ -43,30 +53,43 @@ type property[T comparable] struct{
 }
 
 func (p *property[T]) Change(value T) error {
+	events, err := p.change(value)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		p.dispatcher.Send(event)
+	}
+	return nil
+}
+
+// change updates datasource and cache, it returns events
+// whose rules are satisfied by the new value.
+// A datasource reporting root.ErrNotFound has no current value,
+// so any value is considered a change.
+func (p *property[T]) change(value T) ([]int, error) {
 	if p.cache == nil {
-		temp, err := p.Value()
-		if err != nil {
-			return err
+		if _, err := p.Value(); err != nil && !errors.Is(err, root.ErrNotFound) {
+			return nil, err
 		}
-		p.cache = &temp
 	}
-	if *p.cache == value {
-		return nil
+	if p.cache != nil && *p.cache == value {
+		return nil, nil
 	}
 	if err := p.cons.Evaluate(value); err != nil {
-		return err
+		return nil, err
 	}
 	if err := p.datasource.Change(value); err != nil {
-		return err
+		return nil, err
 	}
 	p.cache = &value
+	var events []int
 	for event, rule := range p.events {
 		if rule.Evaluate(value) {
-			p.dispatcher.Send(event)
+			events = append(events, event)
 		}
 	}
-	return nil
-	
+	return events, nil
 }
 
 func (p *property[T]) Value() (T, error) {
@@ -92,6 +115,18 @@ func (p *property[T]) Cache(value T) {
 	p.cache =&value
 }
 
+func (p *property[T]) Invalidate() {
+	p.cache = nil
+}
+
+func (p *property[T]) Refresh() error {
+	temp, err := p.datasource.Value()
+	if err == nil {
+		p.cache = &temp
+	}
+	return err
+}
+
 func (p *property[T]) Publish(event int, r rule.Rule[T]) bool {
 	if r == nil {
 		return false
@@ -0,0 +1,112 @@
+package x
+
+import (
+	"sync"
+
+	"github.com/begopher/event"
+	"github.com/begopher/rule"
+)
+
+// SyncSimple is the concurrency-safe variant of Simple, it can be shared
+// by multiple goroutines.
+//
+// Change messages are serialized, Value messages run in parallel once the
+// value is cached. Registrations are notified after the state lock is
+// released, so they may send Change and Value to the same property, but
+// they must not Publish, Unpublish, Bind or Unbind synchronously.
+func SyncSimple[T comparable](datasource Datasource[T]) Property[T] {
+	if datasource == nil {
+		panic("property.SyncSimple: datasource cannot be nil")
+	}
+	return &syncProperty[T]{
+		property: newProperty(datasource),
+	}
+}
+
+type syncProperty[T comparable] struct {
+	// mu guards cache, constraints and events of property
+	mu sync.RWMutex
+	// dispatch guards dispatcher of property
+	dispatch sync.RWMutex
+	property *property[T]
+}
+
+func (s *syncProperty[T]) Change(value T) error {
+	s.mu.Lock()
+	events, err := s.property.change(value)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	s.dispatch.RLock()
+	defer s.dispatch.RUnlock()
+	for _, event := range events {
+		s.property.dispatcher.Send(event)
+	}
+	return nil
+}
+
+func (s *syncProperty[T]) Value() (T, error) {
+	s.mu.RLock()
+	if s.property.cache != nil {
+		value := *s.property.cache
+		s.mu.RUnlock()
+		return value, nil
+	}
+	s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.property.Value()
+}
+
+func (s *syncProperty[T]) Constraints(cons rule.Constraint[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.property.Constraints(cons)
+}
+
+func (s *syncProperty[T]) Cache(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.property.Cache(value)
+}
+
+func (s *syncProperty[T]) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.property.Invalidate()
+}
+
+func (s *syncProperty[T]) Refresh() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.property.Refresh()
+}
+
+func (s *syncProperty[T]) Publish(event int, r rule.Rule[T]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatch.Lock()
+	defer s.dispatch.Unlock()
+	return s.property.Publish(event, r)
+}
+
+func (s *syncProperty[T]) Unpublish(event int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatch.Lock()
+	defer s.dispatch.Unlock()
+	return s.property.Unpublish(event)
+}
+
+func (s *syncProperty[T]) Bind(event int, reg event.Registration) error {
+	s.dispatch.Lock()
+	defer s.dispatch.Unlock()
+	return s.property.Bind(event, reg)
+}
+
+func (s *syncProperty[T]) Unbind(event int, reg event.Registration) error {
+	s.dispatch.Lock()
+	defer s.dispatch.Unlock()
+	return s.property.Unbind(event, reg)
+}
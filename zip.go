@@ -0,0 +1,90 @@
+package property
+
+// Pair is the value of a property created by Zip2.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is the value of a property created by Zip3.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip2 returns implementation of Property[Pair[A, B]] interface which
+// combines two properties, so a single Guard can validate them together.
+//
+// messages:
+//   - Change writes first then second as a Transaction, when second fails
+//     first is restored to its old value and *TransactionError is returned.
+//   - Value  reads first then second.
+//
+// # Panic when first or second is nil
+func Zip2[A, B any](first Property[A], second Property[B]) zip2[A, B] {
+	if first == nil || second == nil {
+		panic("property.Zip2: cannot be created from nil property")
+	}
+	return zip2[A, B]{first, second}
+}
+
+type zip2[A, B any] struct {
+	first  Property[A]
+	second Property[B]
+}
+
+func (z zip2[A, B]) Change(value Pair[A, B]) error {
+	return Transaction(
+		ChangeStep(z.first, value.First),
+		ChangeStep(z.second, value.Second),
+	)
+}
+
+func (z zip2[A, B]) Value() (Pair[A, B], error) {
+	var pair Pair[A, B]
+	var err error
+	if pair.First, err = z.first.Value(); err != nil {
+		return pair, err
+	}
+	pair.Second, err = z.second.Value()
+	return pair, err
+}
+
+// Zip3 returns implementation of Property[Triple[A, B, C]] interface which
+// combines three properties, see Zip2.
+//
+// # Panic when first, second or third is nil
+func Zip3[A, B, C any](first Property[A], second Property[B], third Property[C]) zip3[A, B, C] {
+	if first == nil || second == nil || third == nil {
+		panic("property.Zip3: cannot be created from nil property")
+	}
+	return zip3[A, B, C]{first, second, third}
+}
+
+type zip3[A, B, C any] struct {
+	first  Property[A]
+	second Property[B]
+	third  Property[C]
+}
+
+func (z zip3[A, B, C]) Change(value Triple[A, B, C]) error {
+	return Transaction(
+		ChangeStep(z.first, value.First),
+		ChangeStep(z.second, value.Second),
+		ChangeStep(z.third, value.Third),
+	)
+}
+
+func (z zip3[A, B, C]) Value() (Triple[A, B, C], error) {
+	var triple Triple[A, B, C]
+	var err error
+	if triple.First, err = z.first.Value(); err != nil {
+		return triple, err
+	}
+	if triple.Second, err = z.second.Value(); err != nil {
+		return triple, err
+	}
+	triple.Third, err = z.third.Value()
+	return triple, err
+}